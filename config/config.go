@@ -0,0 +1,318 @@
+// Package config reúne la configuración del servidor gRPC y de la conexión a
+// MongoDB. Los valores se toman, en orden de prioridad creciente, de los valores
+// por defecto, de un archivo YAML/TOML opcional, de variables de entorno y de flags.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
+	"gopkg.in/yaml.v3"
+)
+
+// Config - Configuración completa del servidor
+type Config struct {
+	// Store es el backend de almacenamiento: "mongo" o "memory"
+	Store string `yaml:"store" toml:"store"`
+	// ListenAddress es la dirección donde escucha el servidor gRPC
+	ListenAddress string `yaml:"listen_address" toml:"listen_address"`
+	// ShutdownTimeout es el tiempo que tiene cada componente para detenerse; el
+	// servidor gRPC lo usa para terminar las solicitudes en curso
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+	Mongo           Mongo         `yaml:"mongo" toml:"mongo"`
+	Health          Health        `yaml:"health" toml:"health"`
+	Pagination      Pagination    `yaml:"pagination" toml:"pagination"`
+	Idempotency     Idempotency   `yaml:"idempotency" toml:"idempotency"`
+	Integrity       Integrity     `yaml:"integrity" toml:"integrity"`
+	Tickets         Tickets       `yaml:"tickets" toml:"tickets"`
+
+	// PrintConfig indica que se debe mostrar la configuración efectiva y salir
+	PrintConfig bool `yaml:"-" toml:"-"`
+}
+
+// Mongo - Configuración de la conexión a MongoDB
+type Mongo struct {
+	URI                    string        `yaml:"uri" toml:"uri"`
+	Database               string        `yaml:"database" toml:"database"`
+	Collections            Collections   `yaml:"collections" toml:"collections"`
+	ConnectTimeout         time.Duration `yaml:"connect_timeout" toml:"connect_timeout"`
+	ServerSelectionTimeout time.Duration `yaml:"server_selection_timeout" toml:"server_selection_timeout"`
+	MaxPoolSize            uint64        `yaml:"max_pool_size" toml:"max_pool_size"`
+	MinPoolSize            uint64        `yaml:"min_pool_size" toml:"min_pool_size"`
+}
+
+// Health - Configuración del chequeo periódico de la base de datos
+type Health struct {
+	// CheckInterval es cada cuánto se hace ping a MongoDB
+	CheckInterval time.Duration `yaml:"check_interval" toml:"check_interval"`
+	// CheckTimeout es el tiempo máximo que se espera la respuesta de cada ping
+	CheckTimeout time.Duration `yaml:"check_timeout" toml:"check_timeout"`
+}
+
+// Pagination - Tamaños de página de los listados completos
+type Pagination struct {
+	// DefaultPageSize se usa cuando la solicitud no indica page_size
+	DefaultPageSize int32 `yaml:"default_page_size" toml:"default_page_size"`
+	// MaxPageSize es el máximo que impone el servidor aunque se pida más
+	MaxPageSize int32 `yaml:"max_page_size" toml:"max_page_size"`
+}
+
+// Idempotency - Configuración de las claves de idempotencia de los Create*
+type Idempotency struct {
+	// TTL es el tiempo durante el cual se recuerda una clave y el ID creado
+	TTL time.Duration `yaml:"ttl" toml:"ttl"`
+}
+
+// Integrity - Control de las referencias entre personas, tickets y proyectos
+type Integrity struct {
+	// ValidateReferences rechaza altas y modificaciones que referencian
+	// tickets, personas o proyectos inexistentes. Está desactivado por defecto
+	// porque los datos existentes pueden tener referencias rotas.
+	ValidateReferences bool `yaml:"validate_references" toml:"validate_references"`
+	// DeletePolicy indica qué pasa al borrar un documento referenciado:
+	// "none" (por defecto) es el modo sin integridad, para datos existentes:
+	// solo borra el documento. "block" rechaza el borrado y "cascade"
+	// actualiza las referencias. El servidor avisa al iniciar si es none.
+	DeletePolicy string `yaml:"delete_policy" toml:"delete_policy"`
+}
+
+// TicketStatuses son los estados de un ticket, con los nombres que se usan en
+// la configuración: el valor del enum TicketStatus sin el prefijo y en minúsculas
+var TicketStatuses = []string{"open", "in_progress", "blocked", "resolved", "closed"}
+
+// DefaultTicketSequence es la secuencia que numera los tickets creados sin
+// ticket_numero ni sequence
+const DefaultTicketSequence = "default"
+
+// Tickets - Reglas de los tickets
+type Tickets struct {
+	// Transitions indica a qué estados puede pasar un ticket desde cada estado
+	// con TransitionTicket. Un estado sin transiciones es final. En el archivo
+	// de configuración, cada estado que aparece reemplaza sus transiciones por
+	// defecto y los demás las conservan.
+	Transitions map[string][]string `yaml:"transitions" toml:"transitions"`
+	// Sequences son los contadores con los que el servidor numera los tickets
+	// creados sin ticket_numero, con el primer número de cada uno. Todas las
+	// secuencias comparten los números: para que no se pisen, cada una debe
+	// empezar en un rango distinto (por ejemplo, una por oficina).
+	Sequences map[string]int32 `yaml:"sequences" toml:"sequences"`
+}
+
+// Collections - Nombres de las colecciones de la base de datos
+type Collections struct {
+	Personas  string `yaml:"personas" toml:"personas"`
+	Tickets   string `yaml:"tickets" toml:"tickets"`
+	Proyectos string `yaml:"proyectos" toml:"proyectos"`
+	// IdempotencyKeys guarda las claves de idempotencia y el ID que crearon
+	IdempotencyKeys string `yaml:"idempotency_keys" toml:"idempotency_keys"`
+	// TicketCounters guarda el último número asignado de cada secuencia de tickets
+	TicketCounters string `yaml:"ticket_counters" toml:"ticket_counters"`
+	// TicketComments guarda los comentarios de los tickets
+	TicketComments string `yaml:"ticket_comments" toml:"ticket_comments"`
+	// TicketHistory guarda los eventos del historial de los tickets
+	TicketHistory string `yaml:"ticket_history" toml:"ticket_history"`
+}
+
+// Default devuelve la configuración que usaba el servidor antes de ser configurable
+func Default() *Config {
+	return &Config{
+		Store:           "mongo",
+		ListenAddress:   ":50051",
+		ShutdownTimeout: 15 * time.Second,
+		Mongo: Mongo{
+			URI:      "mongodb://go-grpc-mongo-mongodb-1:27017",
+			Database: "argentina_office",
+			Collections: Collections{
+				Personas:        "personas",
+				Tickets:         "tickets",
+				Proyectos:       "proyectos",
+				IdempotencyKeys: "idempotency_keys",
+				TicketCounters:  "ticket_counters",
+				TicketComments:  "ticket_comments",
+				TicketHistory:   "ticket_history",
+			},
+			ConnectTimeout:         10 * time.Second,
+			ServerSelectionTimeout: 5 * time.Second,
+			MaxPoolSize:            100,
+			MinPoolSize:            0,
+		},
+		Health: Health{
+			CheckInterval: 5 * time.Second,
+			CheckTimeout:  2 * time.Second,
+		},
+		Pagination: Pagination{
+			DefaultPageSize: 100,
+			MaxPageSize:     1000,
+		},
+		Idempotency: Idempotency{
+			TTL: 24 * time.Hour,
+		},
+		Integrity: Integrity{
+			ValidateReferences: false,
+			DeletePolicy:       "none",
+		},
+		Tickets: Tickets{
+			Transitions: map[string][]string{
+				"open":        {"in_progress", "blocked", "resolved", "closed"},
+				"in_progress": {"open", "blocked", "resolved"},
+				"blocked":     {"open", "in_progress"},
+				"resolved":    {"open", "closed"},
+				"closed":      {},
+			},
+			Sequences: map[string]int32{
+				DefaultTicketSequence: 1,
+			},
+		},
+	}
+}
+
+// Validate comprueba que la configuración sea utilizable y devuelve todos los errores encontrados
+func (c *Config) Validate() error {
+	var errs []error
+
+	switch c.Store {
+	case "mongo", "memory":
+	default:
+		errs = append(errs, fmt.Errorf("store: valor desconocido %q (opciones: mongo, memory)", c.Store))
+	}
+	if _, _, err := net.SplitHostPort(c.ListenAddress); err != nil {
+		errs = append(errs, fmt.Errorf("listen_address: %w", err))
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown_timeout: debe ser mayor que cero"))
+	}
+
+	if c.Health.CheckInterval <= 0 {
+		errs = append(errs, errors.New("health.check_interval: debe ser mayor que cero"))
+	}
+	if c.Health.CheckTimeout <= 0 {
+		errs = append(errs, errors.New("health.check_timeout: debe ser mayor que cero"))
+	}
+	if c.Pagination.DefaultPageSize <= 0 {
+		errs = append(errs, errors.New("pagination.default_page_size: debe ser mayor que cero"))
+	}
+	if c.Pagination.DefaultPageSize > c.Pagination.MaxPageSize {
+		errs = append(errs, fmt.Errorf("pagination.default_page_size (%d) no puede ser mayor que pagination.max_page_size (%d)", c.Pagination.DefaultPageSize, c.Pagination.MaxPageSize))
+	}
+	if c.Idempotency.TTL <= 0 {
+		errs = append(errs, errors.New("idempotency.ttl: debe ser mayor que cero"))
+	}
+	switch c.Integrity.DeletePolicy {
+	case "none", "block", "cascade":
+	default:
+		errs = append(errs, fmt.Errorf("integrity.delete_policy: valor desconocido %q (opciones: none, block, cascade)", c.Integrity.DeletePolicy))
+	}
+
+	errs = append(errs, c.Tickets.validate()...)
+
+	if c.Store == "mongo" {
+		errs = append(errs, c.Mongo.validate()...)
+	}
+	return errors.Join(errs...)
+}
+
+func (m *Mongo) validate() []error {
+	var errs []error
+
+	if _, err := connstring.ParseAndValidate(m.URI); err != nil {
+		errs = append(errs, fmt.Errorf("mongo.uri: %w", err))
+	}
+	if m.Database == "" {
+		errs = append(errs, errors.New("mongo.database: no puede estar vacío"))
+	}
+
+	seen := map[string]string{}
+	for _, c := range []struct{ key, name string }{
+		{"personas", m.Collections.Personas},
+		{"tickets", m.Collections.Tickets},
+		{"proyectos", m.Collections.Proyectos},
+		{"idempotency_keys", m.Collections.IdempotencyKeys},
+		{"ticket_counters", m.Collections.TicketCounters},
+		{"ticket_comments", m.Collections.TicketComments},
+		{"ticket_history", m.Collections.TicketHistory},
+	} {
+		key, name := c.key, c.name
+		if name == "" {
+			errs = append(errs, fmt.Errorf("mongo.collections.%s: no puede estar vacío", key))
+			continue
+		}
+		if other, ok := seen[name]; ok {
+			errs = append(errs, fmt.Errorf("mongo.collections: %s y %s usan la misma colección %q", other, key, name))
+		}
+		seen[name] = key
+	}
+
+	if m.ConnectTimeout <= 0 {
+		errs = append(errs, errors.New("mongo.connect_timeout: debe ser mayor que cero"))
+	}
+	if m.ServerSelectionTimeout <= 0 {
+		errs = append(errs, errors.New("mongo.server_selection_timeout: debe ser mayor que cero"))
+	}
+	// MaxPoolSize 0 significa sin límite para el driver de MongoDB
+	if m.MaxPoolSize != 0 && m.MinPoolSize > m.MaxPoolSize {
+		errs = append(errs, fmt.Errorf("mongo.min_pool_size (%d) no puede ser mayor que mongo.max_pool_size (%d)", m.MinPoolSize, m.MaxPoolSize))
+	}
+	return errs
+}
+
+func (t *Tickets) validate() []error {
+	var errs []error
+	for _, from := range slices.Sorted(maps.Keys(t.Transitions)) {
+		targets := t.Transitions[from]
+		if !slices.Contains(TicketStatuses, from) {
+			errs = append(errs, fmt.Errorf("tickets.transitions: estado desconocido %q (opciones: %s)", from, strings.Join(TicketStatuses, ", ")))
+		}
+		for _, to := range targets {
+			if !slices.Contains(TicketStatuses, to) {
+				errs = append(errs, fmt.Errorf("tickets.transitions.%s: estado desconocido %q (opciones: %s)", from, to, strings.Join(TicketStatuses, ", ")))
+			}
+		}
+	}
+
+	if _, ok := t.Sequences[DefaultTicketSequence]; !ok {
+		errs = append(errs, fmt.Errorf("tickets.sequences: falta la secuencia %q", DefaultTicketSequence))
+	}
+	for _, name := range slices.Sorted(maps.Keys(t.Sequences)) {
+		if strings.TrimSpace(name) == "" {
+			errs = append(errs, errors.New("tickets.sequences: el nombre de una secuencia no puede estar vacío"))
+		} else if t.Sequences[name] <= 0 {
+			errs = append(errs, fmt.Errorf("tickets.sequences.%s: el primer número debe ser mayor que cero", name))
+		}
+	}
+	return errs
+}
+
+// Dump devuelve la configuración en YAML, ocultando la contraseña de la URI
+func (c *Config) Dump() (string, error) {
+	redacted := *c
+	redacted.Mongo.URI = redactURI(c.Mongo.URI)
+
+	out, err := yaml.Marshal(&redacted)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// redactURI reemplaza la contraseña de una URI de MongoDB por "xxxxx"
+func redactURI(uri string) string {
+	scheme, rest, ok := strings.Cut(uri, "://")
+	if !ok {
+		return uri
+	}
+	userinfo, hosts, ok := strings.Cut(rest, "@")
+	if !ok {
+		return uri
+	}
+	user, _, hasPassword := strings.Cut(userinfo, ":")
+	if !hasPassword {
+		return uri
+	}
+	return scheme + "://" + user + ":xxxxx@" + hosts
+}
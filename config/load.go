@@ -0,0 +1,294 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// setting describe un valor configurable por variable de entorno y por flag
+type setting struct {
+	flag  string
+	env   string
+	usage string
+	value func(c *Config) flag.Value
+}
+
+var settings = []setting{
+	{"store", "STORE_BACKEND", "Backend de almacenamiento: mongo o memory",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Store) }},
+	{"listen", "LISTEN_ADDRESS", "Dirección donde escucha el servidor gRPC",
+		func(c *Config) flag.Value { return (*stringValue)(&c.ListenAddress) }},
+	{"shutdown-timeout", "SHUTDOWN_TIMEOUT", "Tiempo para terminar las solicitudes en curso al detener el servidor (ej. 15s)",
+		func(c *Config) flag.Value { return (*durationValue)(&c.ShutdownTimeout) }},
+	{"mongo-uri", "MONGO_URI", "URI de conexión a MongoDB",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Mongo.URI) }},
+	{"mongo-database", "MONGO_DATABASE", "Nombre de la base de datos",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Mongo.Database) }},
+	{"mongo-collection-personas", "MONGO_COLLECTION_PERSONAS", "Nombre de la colección de personas",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Mongo.Collections.Personas) }},
+	{"mongo-collection-tickets", "MONGO_COLLECTION_TICKETS", "Nombre de la colección de tickets",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Mongo.Collections.Tickets) }},
+	{"mongo-collection-proyectos", "MONGO_COLLECTION_PROYECTOS", "Nombre de la colección de proyectos",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Mongo.Collections.Proyectos) }},
+	{"mongo-collection-idempotency-keys", "MONGO_COLLECTION_IDEMPOTENCY_KEYS", "Nombre de la colección de claves de idempotencia",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Mongo.Collections.IdempotencyKeys) }},
+	{"mongo-collection-ticket-counters", "MONGO_COLLECTION_TICKET_COUNTERS", "Nombre de la colección de contadores de números de tickets",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Mongo.Collections.TicketCounters) }},
+	{"mongo-collection-ticket-comments", "MONGO_COLLECTION_TICKET_COMMENTS", "Nombre de la colección de comentarios de tickets",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Mongo.Collections.TicketComments) }},
+	{"mongo-collection-ticket-history", "MONGO_COLLECTION_TICKET_HISTORY", "Nombre de la colección del historial de tickets",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Mongo.Collections.TicketHistory) }},
+	{"mongo-connect-timeout", "MONGO_CONNECT_TIMEOUT", "Tiempo máximo para conectar con MongoDB (ej. 10s)",
+		func(c *Config) flag.Value { return (*durationValue)(&c.Mongo.ConnectTimeout) }},
+	{"mongo-server-selection-timeout", "MONGO_SERVER_SELECTION_TIMEOUT", "Tiempo máximo para elegir un servidor de MongoDB (ej. 5s)",
+		func(c *Config) flag.Value { return (*durationValue)(&c.Mongo.ServerSelectionTimeout) }},
+	{"mongo-max-pool-size", "MONGO_MAX_POOL_SIZE", "Cantidad máxima de conexiones a MongoDB (0 = sin límite)",
+		func(c *Config) flag.Value { return (*uint64Value)(&c.Mongo.MaxPoolSize) }},
+	{"mongo-min-pool-size", "MONGO_MIN_POOL_SIZE", "Cantidad mínima de conexiones a MongoDB",
+		func(c *Config) flag.Value { return (*uint64Value)(&c.Mongo.MinPoolSize) }},
+	{"health-check-interval", "HEALTH_CHECK_INTERVAL", "Intervalo entre pings a MongoDB para el servicio de health (ej. 5s)",
+		func(c *Config) flag.Value { return (*durationValue)(&c.Health.CheckInterval) }},
+	{"health-check-timeout", "HEALTH_CHECK_TIMEOUT", "Tiempo máximo de cada ping del servicio de health (ej. 2s)",
+		func(c *Config) flag.Value { return (*durationValue)(&c.Health.CheckTimeout) }},
+	{"default-page-size", "DEFAULT_PAGE_SIZE", "Tamaño de página cuando la solicitud no indica page_size",
+		func(c *Config) flag.Value { return (*int32Value)(&c.Pagination.DefaultPageSize) }},
+	{"max-page-size", "MAX_PAGE_SIZE", "Tamaño de página máximo que impone el servidor",
+		func(c *Config) flag.Value { return (*int32Value)(&c.Pagination.MaxPageSize) }},
+	{"idempotency-ttl", "IDEMPOTENCY_TTL", "Tiempo durante el cual se recuerda una idempotency_key (ej. 24h)",
+		func(c *Config) flag.Value { return (*durationValue)(&c.Idempotency.TTL) }},
+	{"validate-references", "VALIDATE_REFERENCES", "Rechaza altas y modificaciones con referencias a documentos inexistentes",
+		func(c *Config) flag.Value { return (*boolValue)(&c.Integrity.ValidateReferences) }},
+	{"delete-policy", "DELETE_POLICY", "Qué hacer al borrar un documento referenciado: none, block o cascade",
+		func(c *Config) flag.Value { return (*stringValue)(&c.Integrity.DeletePolicy) }},
+	{"ticket-transitions", "TICKET_TRANSITIONS", "Transiciones de estado de los tickets (ej. open=in_progress,closed;in_progress=resolved;resolved=;closed=)",
+		func(c *Config) flag.Value { return (*transitionsValue)(&c.Tickets.Transitions) }},
+	{"ticket-sequences", "TICKET_SEQUENCES", "Secuencias de números de tickets y el primer número de cada una (ej. default=1,cordoba=100000)",
+		func(c *Config) flag.Value { return (*sequencesValue)(&c.Tickets.Sequences) }},
+}
+
+// Load arma la configuración a partir de los valores por defecto, el archivo
+// indicado con -config (o CONFIG_FILE), las variables de entorno y los flags.
+// La configuración devuelta ya está validada.
+func Load(args []string) (*Config, error) {
+	return LoadFlagSet(flag.NewFlagSet("server", flag.ContinueOnError), args)
+}
+
+// LoadFlagSet es como Load pero registra los flags en fs, para que un
+// subcomando pueda agregar los suyos antes de interpretar args
+func LoadFlagSet(fs *flag.FlagSet, args []string) (*Config, error) {
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "Archivo de configuración YAML o TOML")
+	printConfig := fs.Bool("print-config", false, "Muestra la configuración efectiva y sale")
+
+	// Los flags se guardan y se aplican al final para que tengan prioridad
+	// sobre el archivo y las variables de entorno.
+	type flagValue struct {
+		setting setting
+		raw     string
+	}
+	var flags []flagValue
+	for _, s := range settings {
+		s := s
+		usage := fmt.Sprintf("%s (env %s)", s.usage, s.env)
+		collect := func(raw string) error {
+			flags = append(flags, flagValue{setting: s, raw: raw})
+			return nil
+		}
+		if b, ok := s.value(Default()).(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+			fs.BoolFunc(s.flag, usage, collect)
+		} else {
+			fs.Func(s.flag, usage, collect)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := Default()
+	if *configFile != "" {
+		if err := loadFile(*configFile, cfg); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range settings {
+		raw, ok := os.LookupEnv(s.env)
+		if !ok {
+			continue
+		}
+		if err := s.value(cfg).Set(raw); err != nil {
+			return nil, fmt.Errorf("variable de entorno %s: %w", s.env, err)
+		}
+	}
+	for _, f := range flags {
+		if err := f.setting.value(cfg).Set(f.raw); err != nil {
+			return nil, fmt.Errorf("flag -%s: %w", f.setting.flag, err)
+		}
+	}
+	cfg.PrintConfig = *printConfig
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("configuración inválida:\n%w", err)
+	}
+	return cfg, nil
+}
+
+// loadFile lee el archivo de configuración según su extensión
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("no se pudo leer el archivo de configuración: %w", err)
+	}
+
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, cfg)
+	case ".toml":
+		err = toml.Unmarshal(data, cfg)
+	default:
+		return fmt.Errorf("formato de archivo de configuración no soportado: %s (usar .yaml, .yml o .toml)", path)
+	}
+	if err != nil {
+		return fmt.Errorf("error al leer %s: %w", path, err)
+	}
+	return nil
+}
+
+type stringValue string
+
+func (v *stringValue) Set(raw string) error { *v = stringValue(raw); return nil }
+func (v *stringValue) String() string       { return string(*v) }
+
+type boolValue bool
+
+func (v *boolValue) Set(raw string) error {
+	b, err := strconv.ParseBool(raw)
+	if err != nil {
+		return err
+	}
+	*v = boolValue(b)
+	return nil
+}
+func (v *boolValue) String() string { return strconv.FormatBool(bool(*v)) }
+
+// IsBoolFlag permite usar -flag sin valor, como los flags booleanos estándar
+func (v *boolValue) IsBoolFlag() bool { return true }
+
+type durationValue time.Duration
+
+func (v *durationValue) Set(raw string) error {
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return err
+	}
+	*v = durationValue(d)
+	return nil
+}
+func (v *durationValue) String() string { return time.Duration(*v).String() }
+
+type uint64Value uint64
+
+func (v *uint64Value) Set(raw string) error {
+	n, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return err
+	}
+	*v = uint64Value(n)
+	return nil
+}
+func (v *uint64Value) String() string { return strconv.FormatUint(uint64(*v), 10) }
+
+type int32Value int32
+
+func (v *int32Value) Set(raw string) error {
+	n, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return err
+	}
+	*v = int32Value(n)
+	return nil
+}
+func (v *int32Value) String() string { return strconv.FormatInt(int64(*v), 10) }
+
+// transitionsValue interpreta "estado=destino,destino;estado=..." y reemplaza
+// todas las transiciones. Un estado sin destinos ("closed=") es final.
+type transitionsValue map[string][]string
+
+func (v *transitionsValue) Set(raw string) error {
+	transitions := map[string][]string{}
+	for _, entry := range strings.Split(raw, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		from, targets, ok := strings.Cut(entry, "=")
+		if !ok {
+			return fmt.Errorf("transición inválida %q (usar estado=destino,destino)", entry)
+		}
+		list := []string{}
+		for _, to := range strings.Split(targets, ",") {
+			if to = strings.TrimSpace(to); to != "" {
+				list = append(list, to)
+			}
+		}
+		transitions[strings.TrimSpace(from)] = list
+	}
+	*v = transitions
+	return nil
+}
+
+func (v *transitionsValue) String() string {
+	froms := make([]string, 0, len(*v))
+	for from := range *v {
+		froms = append(froms, from)
+	}
+	sort.Strings(froms)
+	entries := make([]string, len(froms))
+	for i, from := range froms {
+		entries[i] = from + "=" + strings.Join((*v)[from], ",")
+	}
+	return strings.Join(entries, ";")
+}
+
+// sequencesValue interpreta "nombre=primero,nombre=primero" y reemplaza todas
+// las secuencias
+type sequencesValue map[string]int32
+
+func (v *sequencesValue) Set(raw string) error {
+	sequences := map[string]int32{}
+	for _, entry := range strings.Split(raw, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		name, first, ok := strings.Cut(entry, "=")
+		if !ok {
+			return fmt.Errorf("secuencia inválida %q (usar nombre=primer_número)", entry)
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(first), 10, 32)
+		if err != nil {
+			return fmt.Errorf("secuencia %s: %w", strings.TrimSpace(name), err)
+		}
+		sequences[strings.TrimSpace(name)] = int32(n)
+	}
+	*v = sequences
+	return nil
+}
+
+func (v *sequencesValue) String() string {
+	names := make([]string, 0, len(*v))
+	for name := range *v {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	entries := make([]string, len(names))
+	for i, name := range names {
+		entries[i] = name + "=" + strconv.FormatInt(int64((*v)[name]), 10)
+	}
+	return strings.Join(entries, ",")
+}
@@ -2,8 +2,11 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"go-grpc-mongo/config"
+
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -12,19 +15,38 @@ import (
 var client *mongo.Client
 var collection *mongo.Collection
 
-// ConnectDB establece la conexión con MongoDB y devuelve el cliente
-func ConnectDB() (*mongo.Client, error) {
+// Connect crea el cliente de MongoDB sin verificar que el servidor responda.
+// El driver se reconecta solo, por lo que el cliente sirve aunque la base de
+// datos todavía no esté disponible.
+func Connect(cfg config.Mongo) (*mongo.Client, error) {
+	opts := options.Client().
+		ApplyURI(cfg.URI).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetServerSelectionTimeout(cfg.ServerSelectionTimeout).
+		SetMaxPoolSize(cfg.MaxPoolSize).
+		SetMinPoolSize(cfg.MinPoolSize)
+
 	var err error
-	client, err = mongo.Connect(context.TODO(), options.Client().ApplyURI("mongodb://go-grpc-mongo-mongodb-1:27017"))
+	client, err = mongo.Connect(context.TODO(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+	collection = client.Database(cfg.Database).Collection(cfg.Collections.Personas)
+	return client, nil
+}
+
+// ConnectDB establece la conexión con MongoDB y devuelve el cliente
+func ConnectDB(cfg config.Mongo) (*mongo.Client, error) {
+	client, err := Connect(cfg)
 	if err != nil {
-		log.Fatalf("Failed to connect to MongoDB: %v", err)
 		return nil, err
 	}
-	collection = client.Database("argentina_office").Collection("personas")
 
 	// Verifica la conexión
-	if err := client.Ping(context.TODO(), nil); err != nil {
-		log.Fatalf("Failed to ping MongoDB: %v", err)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
+	defer cancel()
+	if err := Ping(ctx, client); err != nil {
+		client.Disconnect(context.TODO())
 		return nil, err
 	}
 
@@ -32,6 +54,14 @@ func ConnectDB() (*mongo.Client, error) {
 	return client, nil
 }
 
+// Ping verifica que el servidor de MongoDB responda
+func Ping(ctx context.Context, client *mongo.Client) error {
+	if err := client.Ping(ctx, nil); err != nil {
+		return fmt.Errorf("failed to ping MongoDB: %w", err)
+	}
+	return nil
+}
+
 // InsertDummyData inserta datos de ejemplo en la colección "personas"
 func InsertDummyData() {
 	personas := []interface{}{
@@ -0,0 +1,166 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"go-grpc-mongo/config"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// codeNamespaceNotFound es el error que devuelve listIndexes si la colección no existe
+const codeNamespaceNotFound = 26
+
+// IndexSpec - Índice que necesita el servidor sobre una colección
+type IndexSpec struct {
+	Collection string
+	Name       string
+	Keys       bson.D
+	Unique     bool
+	// TTL indica que los documentos expiran en la fecha del campo indexado
+	TTL bool
+}
+
+// RequiredIndexes devuelve los índices que usan las consultas del servidor.
+// Los índices únicos deben coincidir con los que controla el store en memoria.
+func RequiredIndexes(c config.Collections) []IndexSpec {
+	return []IndexSpec{
+		{Collection: c.Tickets, Name: "ticket_numero_unique", Keys: bson.D{{Key: "ticket_numero", Value: 1}}, Unique: true},
+		{Collection: c.Tickets, Name: "owner", Keys: bson.D{{Key: "owner", Value: 1}, {Key: "ticket_numero", Value: 1}}},
+		{Collection: c.Tickets, Name: "owner_id", Keys: bson.D{{Key: "owner_id", Value: 1}, {Key: "ticket_numero", Value: 1}}},
+		{Collection: c.Tickets, Name: "status", Keys: bson.D{{Key: "status", Value: 1}}},
+		{Collection: c.Personas, Name: "nombre", Keys: bson.D{{Key: "nombre", Value: 1}}},
+		{Collection: c.Personas, Name: "edad", Keys: bson.D{{Key: "edad", Value: 1}}},
+		{Collection: c.Personas, Name: "proyecto_id", Keys: bson.D{{Key: "proyecto_id", Value: 1}}},
+		{Collection: c.Proyectos, Name: "nombre_unique", Keys: bson.D{{Key: "nombre", Value: 1}}, Unique: true},
+		{Collection: c.Proyectos, Name: "colaboradores", Keys: bson.D{{Key: "colaboradores", Value: 1}}},
+		{Collection: c.Proyectos, Name: "colaborador_ids", Keys: bson.D{{Key: "colaborador_ids", Value: 1}}},
+		{Collection: c.Proyectos, Name: "dificultad", Keys: bson.D{{Key: "dificultad", Value: 1}}},
+		{Collection: c.TicketComments, Name: "ticket_id", Keys: bson.D{{Key: "ticket_id", Value: 1}, {Key: "_id", Value: 1}}},
+		{Collection: c.TicketHistory, Name: "ticket_id", Keys: bson.D{{Key: "ticket_id", Value: 1}, {Key: "_id", Value: 1}}},
+		{Collection: c.IdempotencyKeys, Name: "expires_at_ttl", Keys: bson.D{{Key: "expires_at", Value: 1}}, TTL: true},
+	}
+}
+
+// existingIndex - Definición de un índice tal como la devuelve listIndexes
+type existingIndex struct {
+	Name               string `bson:"name"`
+	Key                bson.D `bson:"key"`
+	Unique             bool   `bson:"unique"`
+	ExpireAfterSeconds *int32 `bson:"expireAfterSeconds"`
+}
+
+// matches indica si el índice existente cumple la especificación
+func (e existingIndex) matches(spec IndexSpec) bool {
+	ttl := e.ExpireAfterSeconds != nil && *e.ExpireAfterSeconds == 0
+	return sameKeys(e.Key, spec.Keys) && e.Unique == spec.Unique && ttl == spec.TTL
+}
+
+// sameKeys compara campos y dirección; MongoDB puede devolver la dirección
+// como int32, int64 o double según cómo se creó el índice
+func sameKeys(a, b bson.D) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Key != b[i].Key || direction(a[i].Value) != direction(b[i].Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func direction(v interface{}) interface{} {
+	switch n := v.(type) {
+	case int32:
+		return int64(n)
+	case int:
+		return int64(n)
+	case float64:
+		return int64(n)
+	}
+	return v
+}
+
+// EnsureIndexes crea los índices que faltan y recrea los que tienen otra
+// definición. Los índices que no están en specs no se modifican. Sigue con
+// los demás índices si uno falla y devuelve todos los errores.
+func EnsureIndexes(ctx context.Context, database *mongo.Database, specs []IndexSpec) error {
+	var errs []error
+	for _, spec := range specs {
+		if err := ensureIndex(ctx, database.Collection(spec.Collection), spec); err != nil {
+			errs = append(errs, fmt.Errorf("índice %s.%s: %w", spec.Collection, spec.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func ensureIndex(ctx context.Context, collection *mongo.Collection, spec IndexSpec) error {
+	existing, err := listIndexes(ctx, collection)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range existing {
+		switch {
+		case index.Name == spec.Name && index.matches(spec):
+			return nil
+		case index.Name == spec.Name || sameKeys(index.Key, spec.Keys):
+			// MongoDB no permite dos índices con el mismo nombre o las mismas
+			// claves, así que se reemplaza el existente
+			if index.matches(spec) {
+				log.Printf("El índice %s.%s ya existe con el nombre %s", collection.Name(), spec.Name, index.Name)
+				return nil
+			}
+			log.Printf("Recreando el índice %s.%s porque su definición cambió", collection.Name(), index.Name)
+			if _, err := collection.Indexes().DropOne(ctx, index.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	opts := options.Index().SetName(spec.Name)
+	if spec.Unique {
+		opts.SetUnique(true)
+	}
+	if spec.TTL {
+		opts.SetExpireAfterSeconds(0)
+	}
+	if _, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: spec.Keys, Options: opts}); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("hay documentos duplicados, hay que corregirlos antes de crear el índice único: %w", err)
+		}
+		return err
+	}
+	log.Printf("Índice creado: %s.%s", collection.Name(), spec.Name)
+	return nil
+}
+
+// listIndexes devuelve los índices de la colección, sin contar el de _id
+func listIndexes(ctx context.Context, collection *mongo.Collection) ([]existingIndex, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && cmdErr.Code == codeNamespaceNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var indexes []existingIndex
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return nil, err
+	}
+	var result []existingIndex
+	for _, index := range indexes {
+		if index.Name != "_id_" {
+			result = append(result, index)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,120 @@
+// Package healthcheck mantiene actualizado el servicio estándar
+// grpc.health.v1.Health a partir de un ping periódico a la base de datos.
+package healthcheck
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Checker consulta la base de datos cada cierto intervalo y marca los
+// servicios como SERVING o NOT_SERVING según el resultado. Implementa
+// lifecycle.Component.
+type Checker struct {
+	server   *health.Server
+	ping     func(ctx context.Context) error
+	interval time.Duration
+	timeout  time.Duration
+	services []string
+
+	mu      sync.Mutex
+	serving *bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// New crea un Checker para los servicios indicados. El servicio "" (estado
+// general del servidor) se actualiza siempre. Si ping es nil los servicios
+// quedan SERVING mientras el Checker esté iniciado.
+func New(server *health.Server, ping func(ctx context.Context) error, interval, timeout time.Duration, services ...string) *Checker {
+	return &Checker{
+		server:   server,
+		ping:     ping,
+		interval: interval,
+		timeout:  timeout,
+		services: append([]string{""}, services...),
+	}
+}
+
+func (c *Checker) Name() string { return "health" }
+
+// Start hace un primer chequeo y luego repite el ping en segundo plano
+func (c *Checker) Start(ctx context.Context) error {
+	c.check(ctx)
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.done = make(chan struct{})
+	go c.run(runCtx)
+	return nil
+}
+
+// Stop detiene los chequeos y marca todos los servicios como NOT_SERVING
+func (c *Checker) Stop(ctx context.Context) error {
+	c.cancel()
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	c.server.Shutdown()
+	return nil
+}
+
+func (c *Checker) run(ctx context.Context) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.check(ctx)
+		}
+	}
+}
+
+func (c *Checker) check(ctx context.Context) {
+	if c.ping == nil {
+		c.setServing(true, nil)
+		return
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	err := c.ping(pingCtx)
+	if ctx.Err() != nil {
+		// El Checker se está deteniendo; el resultado del ping no es representativo
+		return
+	}
+	c.setServing(err == nil, err)
+}
+
+// setServing actualiza el estado de todos los servicios y registra solo los cambios
+func (c *Checker) setServing(serving bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.serving != nil && *c.serving == serving {
+		return
+	}
+	c.serving = &serving
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if serving {
+		log.Println("Health: base de datos disponible, servicios en estado SERVING")
+	} else {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+		log.Printf("Health: base de datos no disponible, servicios en estado NOT_SERVING: %v", err)
+	}
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
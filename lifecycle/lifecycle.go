@@ -0,0 +1,132 @@
+// Package lifecycle arranca y detiene los componentes del servidor en orden:
+// se inician en el orden en que se agregan y se detienen en el orden inverso,
+// de modo que el servidor gRPC termine de atender las solicitudes en curso
+// antes de que se cierre la conexión a la base de datos.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Component es una parte del servidor con arranque y detención explícitos
+type Component interface {
+	Name() string
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+// Hook adapta un par de funciones a Component. OnStart y OnStop son opcionales.
+type Hook struct {
+	ComponentName string
+	OnStart       func(ctx context.Context) error
+	OnStop        func(ctx context.Context) error
+}
+
+func (h Hook) Name() string { return h.ComponentName }
+
+func (h Hook) Start(ctx context.Context) error {
+	if h.OnStart == nil {
+		return nil
+	}
+	return h.OnStart(ctx)
+}
+
+func (h Hook) Stop(ctx context.Context) error {
+	if h.OnStop == nil {
+		return nil
+	}
+	return h.OnStop(ctx)
+}
+
+// Manager coordina el ciclo de vida de los componentes registrados
+type Manager struct {
+	mu         sync.Mutex
+	components []Component
+	started    []Component
+	failures   chan error
+}
+
+// New crea un Manager sin componentes
+func New() *Manager {
+	return &Manager{failures: make(chan error, 1)}
+}
+
+// Append registra un componente. Se inicia después de los ya registrados y se
+// detiene antes que ellos.
+func (m *Manager) Append(c Component) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.components = append(m.components, c)
+}
+
+// Go ejecuta una tarea de fondo de un componente (por ejemplo grpc.Server.Serve).
+// Si la tarea termina con error, Run inicia la detención del servidor.
+func (m *Manager) Go(name string, fn func() error) {
+	go func() {
+		if err := fn(); err != nil {
+			select {
+			case m.failures <- fmt.Errorf("%s: %w", name, err):
+			default:
+			}
+		}
+	}()
+}
+
+// Start inicia los componentes en orden y se detiene ante el primer error
+func (m *Manager) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, c := range m.components[len(m.started):] {
+		log.Printf("Iniciando componente: %s", c.Name())
+		if err := c.Start(ctx); err != nil {
+			return fmt.Errorf("no se pudo iniciar %s: %w", c.Name(), err)
+		}
+		m.started = append(m.started, c)
+	}
+	return nil
+}
+
+// Stop detiene los componentes iniciados en orden inverso. Cada componente
+// dispone de timeout para terminar; los errores se acumulan y no interrumpen
+// la detención de los demás.
+func (m *Manager) Stop(timeout time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var errs []error
+	for i := len(m.started) - 1; i >= 0; i-- {
+		c := m.started[i]
+		log.Printf("Deteniendo componente: %s", c.Name())
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		if err := c.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("no se pudo detener %s: %w", c.Name(), err))
+		}
+		cancel()
+	}
+	m.started = nil
+	return errors.Join(errs...)
+}
+
+// Run inicia los componentes y espera a que ctx termine (por ejemplo al
+// recibir SIGTERM) o a que falle una tarea de fondo; luego los detiene.
+func (m *Manager) Run(ctx context.Context, stopTimeout time.Duration) error {
+	if err := m.Start(ctx); err != nil {
+		return errors.Join(err, m.Stop(stopTimeout))
+	}
+
+	var runErr error
+	select {
+	case <-ctx.Done():
+		log.Println("Señal de detención recibida, deteniendo el servidor...")
+	case runErr = <-m.failures:
+		log.Printf("Error en un componente, deteniendo el servidor: %v", runErr)
+	}
+	return errors.Join(runErr, m.Stop(stopTimeout))
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"go-grpc-mongo/config"
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+)
+
+// El número de un ticket borrado se quita de personas.tickets con cualquier
+// política, porque la lista sigue al dueño del ticket
+func TestDeleteTicketReleasesOwner(t *testing.T) {
+	for _, policy := range []string{"none", "cascade"} {
+		t.Run(policy, func(t *testing.T) {
+			ctx := context.Background()
+			cfg := config.Default()
+			cfg.Integrity.DeletePolicy = policy
+			st := store.NewMemoryStore()
+			s := newServer(st, cfg)
+
+			persona, err := s.CreatePersona(ctx, &pb.CreatePersonaRequest{Nombre: "Pedro"})
+			if err != nil {
+				t.Fatalf("CreatePersona: %v", err)
+			}
+			var ids []string
+			for _, numero := range []int32{104, 105} {
+				ticket, err := s.CreateTicket(ctx, &pb.CreateTicketRequest{TicketNumero: numero, OwnerId: persona.Id})
+				if err != nil {
+					t.Fatalf("CreateTicket(%d): %v", numero, err)
+				}
+				ids = append(ids, ticket.Id)
+			}
+
+			if _, err := s.DeleteTicket(ctx, &pb.DeleteTicketRequest{Id: ids[0], Version: store.InitialVersion}); err != nil {
+				t.Fatalf("DeleteTicket: %v", err)
+			}
+			got, err := st.Personas.Get(ctx, persona.Id)
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			if len(got.Tickets) != 1 || got.Tickets[0] != 105 {
+				t.Errorf("tickets = %v, se esperaba [105]", got.Tickets)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"slices"
+
+	"go-grpc-mongo/model"
+	pb "go-grpc-mongo/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resolveDificultad combina dificultad con nivel_dificultad, el string que
+// envían los clientes anteriores al enum. Si llegan los dos deben indicar el
+// mismo nivel.
+func resolveDificultad(dificultad pb.Dificultad, nivel string) (pb.Dificultad, error) {
+	legacy, ok := model.ParseDificultad(nivel)
+	if !ok {
+		return dificultad, status.Errorf(codes.InvalidArgument, "nivel_dificultad %q no es un nivel válido", nivel)
+	}
+	switch {
+	case legacy == pb.Dificultad_DIFICULTAD_UNSPECIFIED:
+		return dificultad, nil
+	case dificultad == pb.Dificultad_DIFICULTAD_UNSPECIFIED:
+		return legacy, nil
+	case dificultad != legacy:
+		return dificultad, status.Errorf(codes.InvalidArgument, "nivel_dificultad %q no corresponde a dificultad %s", nivel, dificultad)
+	}
+	return dificultad, nil
+}
+
+// setDificultad asigna el nivel pedido al proyecto, con nivel_dificultad
+// normalizado al nombre del nivel
+func setDificultad(proyecto *pb.Proyecto, dificultad pb.Dificultad, nivel string) error {
+	dificultad, err := resolveDificultad(dificultad, nivel)
+	if err != nil {
+		return err
+	}
+	proyecto.Dificultad = dificultad
+	proyecto.NivelDificultad = model.NombreDificultad(dificultad)
+	return nil
+}
+
+// dificultadFields agrega el campo que falta del par dificultad y
+// nivel_dificultad, que se escriben siempre juntos
+func dificultadFields(fields []string) []string {
+	hasNivel, hasDificultad := slices.Contains(fields, "nivel_dificultad"), slices.Contains(fields, "dificultad")
+	switch {
+	case hasNivel && !hasDificultad:
+		fields = append(fields, "dificultad")
+	case hasDificultad && !hasNivel:
+		fields = append(fields, "nivel_dificultad")
+	}
+	return fields
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "go-grpc-mongo/proto"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// actorMetadataKey es la cabecera gRPC que identifica a quien hace el cambio.
+// Se guarda en el historial y es el autor por defecto de los comentarios.
+const actorMetadataKey = "actor"
+
+// ownerFields son los campos que cambian cuando un ticket cambia de dueño
+var ownerFields = []string{"owner", "owner_id", "huerfano"}
+
+// actor devuelve el valor de la metadata actor, o vacío si no llegó
+func actor(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, actorMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// fieldChanges devuelve los campos de fields cuyo valor es distinto en before
+// y en after
+func fieldChanges(before, after *pb.Ticket, fields []string) []*pb.FieldChange {
+	b, a := before.ProtoReflect(), after.ProtoReflect()
+	var changes []*pb.FieldChange
+	for _, field := range fields {
+		fd := b.Descriptor().Fields().ByName(protoreflect.Name(field))
+		if b.Get(fd).Equal(a.Get(fd)) {
+			continue
+		}
+		changes = append(changes, &pb.FieldChange{
+			Field:  field,
+			Before: formatValue(fd, b.Get(fd)),
+			After:  formatValue(fd, a.Get(fd)),
+		})
+	}
+	return changes
+}
+
+// formatValue muestra los enums con el nombre del valor y el resto de los
+// campos como texto
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if fd.Kind() == protoreflect.EnumKind {
+		if value := fd.Enum().Values().ByNumber(v.Enum()); value != nil {
+			return string(value.Name())
+		}
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// recordEvent agrega al historial los cambios de fields entre before y after,
+// si hay alguno. Se llama dentro de la transacción del cambio para que el
+// evento se guarde solo si el cambio se confirma.
+func (s *server) recordEvent(ctx context.Context, eventType pb.TicketEventType, before, after *pb.Ticket, fields []string) error {
+	changes := fieldChanges(before, after, fields)
+	if len(changes) == 0 {
+		return nil
+	}
+	return s.history.Append(ctx, &pb.TicketEvent{
+		TicketId:     after.Id,
+		TicketNumero: after.TicketNumero,
+		Type:         eventType,
+		Actor:        actor(ctx),
+		Changes:      changes,
+	})
+}
+
+// ownersChanged actualiza personas.tickets y registra el cambio de dueño de
+// los tickets before, leídos antes de reasignarlos
+func (s *server) ownersChanged(ctx context.Context, before []*pb.Ticket) error {
+	for _, ticket := range before {
+		after, err := s.tickets.Get(ctx, ticket.Id)
+		if err != nil {
+			return err
+		}
+		if _, err := s.syncOwner(ctx, ticket, after); err != nil {
+			return err
+		}
+		if err := s.recordEvent(ctx, pb.TicketEventType_TICKET_EVENT_TYPE_OWNER_CHANGED, ticket, after, ownerFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddTicketComment - Maneja la solicitud para agregar un comentario a un ticket
+func (s *server) AddTicketComment(ctx context.Context, req *pb.AddTicketCommentRequest) (*pb.TicketComment, error) {
+	log.Printf("Agregando comentario al ticket con ID: %s", req.TicketId)
+
+	if _, err := s.tickets.Get(ctx, req.TicketId); err != nil {
+		log.Printf("Error al buscar el ticket: %v", err)
+		return nil, storeError(err, "Ticket no encontrado", "Error al agregar el comentario")
+	}
+	author := req.Author
+	if author == "" {
+		author = actor(ctx)
+	}
+
+	comment, err := s.comments.Add(ctx, &pb.TicketComment{TicketId: req.TicketId, Author: author, Body: req.Body})
+	if err != nil {
+		log.Printf("Error al agregar el comentario: %v", err)
+		return nil, storeError(err, "", "Error al agregar el comentario")
+	}
+
+	log.Printf("Comentario agregado con ID: %s", comment.Id)
+	return comment, nil
+}
+
+// ListTicketComments - Maneja la solicitud para obtener los comentarios de un ticket
+func (s *server) ListTicketComments(ctx context.Context, req *pb.ListTicketCommentsRequest) (*pb.ListTicketCommentsResponse, error) {
+	log.Printf("Buscando comentarios del ticket con ID: %s", req.TicketId)
+
+	page, err := s.page(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	comments, nextPageToken, err := s.comments.List(ctx, req.TicketId, page)
+	if err != nil {
+		log.Printf("Error al obtener los comentarios: %v", err)
+		return nil, storeError(err, "", "Error al obtener los comentarios")
+	}
+
+	log.Printf("Comentarios encontrados: %d", len(comments))
+	return &pb.ListTicketCommentsResponse{Comments: comments, NextPageToken: nextPageToken}, nil
+}
+
+// GetTicketHistory - Maneja la solicitud para obtener el historial de cambios de un ticket
+func (s *server) GetTicketHistory(ctx context.Context, req *pb.GetTicketHistoryRequest) (*pb.GetTicketHistoryResponse, error) {
+	log.Printf("Buscando historial del ticket con ID: %s", req.TicketId)
+
+	page, err := s.page(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	events, nextPageToken, err := s.history.List(ctx, req.TicketId, page)
+	if err != nil {
+		log.Printf("Error al obtener el historial: %v", err)
+		return nil, storeError(err, "", "Error al obtener el historial")
+	}
+
+	log.Printf("Eventos encontrados: %d", len(events))
+	return &pb.GetTicketHistoryResponse{Events: events, NextPageToken: nextPageToken}, nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"go-grpc-mongo/store"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotencyMetadataKey es la cabecera gRPC alternativa al campo idempotency_key
+const idempotencyMetadataKey = "idempotency-key"
+
+// maxIdempotencyKeyLength limita el tamaño de las claves que se guardan
+const maxIdempotencyKeyLength = 256
+
+// idempotencyKey devuelve la clave enviada en el campo de la solicitud o en la
+// metadata. Si llegan las dos deben coincidir.
+func idempotencyKey(ctx context.Context, field string) (string, error) {
+	key := field
+	if values := metadata.ValueFromIncomingContext(ctx, idempotencyMetadataKey); len(values) > 0 {
+		if key != "" && key != values[0] {
+			return "", status.Error(codes.InvalidArgument, "idempotency_key no coincide con la metadata idempotency-key")
+		}
+		key = values[0]
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "idempotency_key no puede superar los %d caracteres", maxIdempotencyKeyLength)
+	}
+	return key, nil
+}
+
+// requestHash calcula la huella del payload sin el campo idempotency_key, para
+// detectar si una repetición trae datos distintos
+func requestHash(req proto.Message) (string, error) {
+	msg := proto.Clone(req).ProtoReflect()
+	if fd := msg.Descriptor().Fields().ByName("idempotency_key"); fd != nil {
+		msg.Clear(fd)
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// idempotent ejecuta create una sola vez por clave. Si la clave ya se usó con
+// el mismo payload devuelve el ID creado originalmente y replayed en true; si
+// se usó con otro payload devuelve ALREADY_EXISTS. Sin clave llama
+// directamente a create. create debe devolver errores gRPC.
+func (s *server) idempotent(ctx context.Context, operation string, req proto.Message, field string, create func() (string, error)) (id string, replayed bool, err error) {
+	key, err := idempotencyKey(ctx, field)
+	if err != nil {
+		return "", false, err
+	}
+	if key == "" {
+		id, err := create()
+		return id, false, err
+	}
+
+	hash, err := requestHash(req)
+	if err != nil {
+		log.Printf("Error al calcular la huella de la solicitud %s: %v", operation, err)
+		return "", false, status.Error(codes.Internal, "Error al procesar idempotency_key")
+	}
+
+	record := store.IdempotencyRecord{
+		Key:         operation + "/" + key,
+		RequestHash: hash,
+		ExpiresAt:   time.Now().Add(s.cfg.Idempotency.TTL),
+	}
+	existing, reserved, err := s.idempotency.Reserve(ctx, record)
+	if err != nil {
+		log.Printf("Error al reservar la idempotency_key %q: %v", key, err)
+		return "", false, status.Error(codes.Internal, "Error al procesar idempotency_key")
+	}
+	if !reserved {
+		switch {
+		case existing.RequestHash != hash:
+			log.Printf("idempotency_key %q reutilizada con otro payload", key)
+			return "", false, status.Error(codes.AlreadyExists, "La idempotency_key ya se usó con otra solicitud")
+		case existing.ResourceID == "":
+			return "", false, status.Error(codes.Aborted, "La solicitud original con esta idempotency_key todavía está en curso")
+		}
+		log.Printf("Repetición de %s con idempotency_key %q: se devuelve el ID %s", operation, key, existing.ResourceID)
+		return existing.ResourceID, true, nil
+	}
+
+	id, err = create()
+	if err != nil {
+		// Si la creación falló se libera la clave para que el cliente pueda reintentar
+		if releaseErr := s.idempotency.Release(context.WithoutCancel(ctx), record.Key); releaseErr != nil {
+			log.Printf("Error al liberar la idempotency_key %q: %v", key, releaseErr)
+		}
+		return "", false, err
+	}
+	if err := s.idempotency.Complete(context.WithoutCancel(ctx), record.Key, id); err != nil {
+		// El documento ya se creó: se informa el ID aunque no quede asociado a la clave
+		log.Printf("Error al guardar el resultado de la idempotency_key %q: %v", key, err)
+	}
+	return id, false, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"go-grpc-mongo/config"
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Una repetición con la misma idempotency_key devuelve la versión actual del
+// documento, que pudo cambiar desde la creación original
+func TestIdempotentReplayVersion(t *testing.T) {
+	ctx := context.Background()
+	st := store.NewMemoryStore()
+	s := newServer(st, config.Default())
+
+	req := &pb.CreatePersonaRequest{Nombre: "Pedro", Edad: 30, IdempotencyKey: "alta-pedro"}
+	created, err := s.CreatePersona(ctx, req)
+	if err != nil {
+		t.Fatalf("CreatePersona: %v", err)
+	}
+	if created.Version != store.InitialVersion {
+		t.Fatalf("versión = %d, se esperaba %d", created.Version, store.InitialVersion)
+	}
+
+	persona, err := st.Personas.Get(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	persona.Edad = 31
+	if err := st.Personas.Update(ctx, persona, []string{"edad"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	replay, err := s.CreatePersona(ctx, req)
+	if err != nil {
+		t.Fatalf("CreatePersona repetida: %v", err)
+	}
+	if replay.Id != created.Id || replay.Version != store.InitialVersion+1 {
+		t.Errorf("respuesta = %v, se esperaba el ID %s con versión %d", replay, created.Id, store.InitialVersion+1)
+	}
+
+	if err := st.Personas.Delete(ctx, created.Id, replay.Version); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.CreatePersona(ctx, req); status.Code(err) != codes.NotFound {
+		t.Errorf("CreatePersona repetida después de borrar = %v, se esperaba NotFound", err)
+	}
+}
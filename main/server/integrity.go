@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"go-grpc-mongo/config"
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// found convierte ErrNotFound en false, para verificar que una referencia exista
+func found[T any](_ T, err error) (bool, error) {
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, store.ErrNotFound):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// preconditionError devuelve FAILED_PRECONDITION con las violaciones como
+// detalle PreconditionFailure
+func preconditionError(message string, violations []*errdetails.PreconditionFailure_Violation) error {
+	st := status.New(codes.FailedPrecondition, message)
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// missingReference describe una referencia a un documento que no existe
+func missingReference(collection, key, description string) *errdetails.PreconditionFailure_Violation {
+	return &errdetails.PreconditionFailure_Violation{
+		Type:        "REFERENCE_NOT_FOUND",
+		Subject:     collection + "/" + key,
+		Description: description,
+	}
+}
+
+// referenceError arma el error de una solicitud con referencias inexistentes
+func referenceError(violations []*errdetails.PreconditionFailure_Violation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	log.Printf("Referencias inexistentes: %d", len(violations))
+	return preconditionError(fmt.Sprintf("La solicitud referencia %d documentos que no existen", len(violations)), violations)
+}
+
+// warnIntegrityOff avisa al iniciar qué controles de integridad referencial
+// están desactivados. Lo están por defecto, para que los datos existentes con
+// referencias rotas sigan funcionando; se activan después de revisar
+// CheckIntegrity.
+func warnIntegrityOff(cfg config.Integrity) {
+	if !cfg.ValidateReferences {
+		log.Println("Atención: validate_references=false; las altas y modificaciones aceptan referencias a documentos inexistentes")
+	}
+	if cfg.DeletePolicy == "none" {
+		log.Println("Atención: delete_policy=none; los borrados no reasignan ni marcan como huérfanos los tickets ni quitan colaboradores (opciones: block, cascade)")
+	}
+}
+
+// keepReferences indica si la política es none: el borrado solo elimina el
+// documento y deja las referencias como estaban
+func (s *server) keepReferences() bool {
+	return s.cfg.Integrity.DeletePolicy == "none"
+}
+
+// blockReferences aplica la política block: si algún documento referencia al
+// que se quiere borrar devuelve FAILED_PRECONDITION con la cantidad de cada colección
+func (s *server) blockReferences(subject string, refs store.References) error {
+	if s.cfg.Integrity.DeletePolicy != "block" || !refs.Any() {
+		return nil
+	}
+
+	var violations []*errdetails.PreconditionFailure_Violation
+	for _, r := range []struct {
+		collection string
+		count      int64
+	}{
+		{"personas", refs.Personas},
+		{"tickets", refs.Tickets},
+		{"proyectos", refs.Proyectos},
+	} {
+		if r.count > 0 {
+			violations = append(violations, &errdetails.PreconditionFailure_Violation{
+				Type:        "REFERENCED",
+				Subject:     subject,
+				Description: fmt.Sprintf("%d documentos de %s lo referencian", r.count, r.collection),
+			})
+		}
+	}
+	log.Printf("Borrado bloqueado: %s tiene referencias %+v", subject, refs)
+	return preconditionError(fmt.Sprintf("No se puede eliminar %s porque otros documentos lo referencian (delete_policy=block)", subject), violations)
+}
+
+// resolveReassign busca la persona que recibe los tickets, que debe existir y
+// no ser la que se está eliminando. Se llama dentro de la transacción del
+// borrado, por lo que no traduce los errores del store.
+func (s *server) resolveReassign(ctx context.Context, persona *pb.Persona, reassignTo store.Ref) (store.Ref, error) {
+	ref, ok, err := resolveRef(ctx, reassignTo, "reassign_tickets_to", s.personaByID, s.personaByNombre)
+	switch {
+	case err != nil:
+		return ref, err
+	case !ok:
+		return ref, referenceError([]*errdetails.PreconditionFailure_Violation{
+			missingReference("personas", refKey(ref), fmt.Sprintf("La persona %s no existe", refKey(ref))),
+		})
+	case ref.ID == persona.Id:
+		return ref, status.Error(codes.InvalidArgument, "reassign_tickets_to_id no puede ser la persona que se elimina")
+	}
+	return ref, nil
+}
+
+// CheckIntegrity - Recorre personas, tickets y proyectos y devuelve las
+// referencias a documentos que no existen. Lee cada colección dos veces: la
+// primera arma los conjuntos de IDs, nombres y números, y la segunda verifica
+// las referencias, por lo que la memoria usada depende de esos conjuntos y no
+// del tamaño de los documentos. Los documentos que no se pueden decodificar se
+// informan en partial_failures y el recorrido sigue.
+func (s *server) CheckIntegrity(ctx context.Context, req *pb.CheckIntegrityRequest) (*pb.CheckIntegrityResponse, error) {
+	log.Println("Iniciando la verificación de integridad referencial.")
+
+	resp := &pb.CheckIntegrityResponse{}
+	// Las fallas se informan en la primera pasada; en la segunda se omiten
+	failed := func(collection string) func(store.DecodeFailure) error {
+		return func(failure store.DecodeFailure) error {
+			log.Printf("Documento de %s omitido porque no se pudo leer: ID=%s: %v", collection, failure.ID, failure.Err)
+			resp.PartialFailures = append(resp.PartialFailures, partialFailure(collection, failure))
+			return nil
+		}
+	}
+	skip := func(store.DecodeFailure) error { return nil }
+
+	personaIDs, nombres := map[string]bool{}, map[string]bool{}
+	numeros := map[int32]bool{}
+	proyectoIDs, nombresProyecto := map[string]bool{}, map[string]bool{}
+	err := errors.Join(
+		s.personas.Stream(ctx, func(p *pb.Persona) error {
+			resp.Personas++
+			personaIDs[p.Id], nombres[p.Nombre] = true, true
+			return nil
+		}, failed("personas")),
+		s.tickets.Stream(ctx, func(t *pb.Ticket) error {
+			resp.Tickets++
+			numeros[t.TicketNumero] = true
+			return nil
+		}, failed("tickets")),
+		s.proyectos.Stream(ctx, func(p *pb.Proyecto) error {
+			resp.Proyectos++
+			proyectoIDs[p.Id], nombresProyecto[p.Nombre] = true, true
+			return nil
+		}, failed("proyectos")),
+	)
+	if err != nil {
+		log.Printf("Error al leer las colecciones: %v", err)
+		return nil, streamError(ctx, err, "Error al verificar la integridad")
+	}
+
+	dangling := func(collection, id, field, value string) {
+		resp.Dangling = append(resp.Dangling, &pb.DanglingReference{Collection: collection, Id: id, Field: field, Value: value})
+	}
+	// Las referencias con ID se verifican por ID; las que solo tienen el
+	// nombre, por nombre
+	ref := func(collection, id, field string, ref store.Ref, ids, nombres map[string]bool) {
+		switch {
+		case ref.ID != "" && !ids[ref.ID]:
+			dangling(collection, id, idField(field), ref.ID)
+		case ref.ID == "" && ref.Nombre != "" && !nombres[ref.Nombre]:
+			dangling(collection, id, field, ref.Nombre)
+		}
+	}
+	err = errors.Join(
+		s.personas.Stream(ctx, func(p *pb.Persona) error {
+			for _, numero := range p.Tickets {
+				if !numeros[numero] {
+					dangling("personas", p.Id, "tickets", strconv.Itoa(int(numero)))
+				}
+			}
+			ref("personas", p.Id, "proyecto", store.Ref{ID: p.ProyectoId, Nombre: p.Proyecto}, proyectoIDs, nombresProyecto)
+			return nil
+		}, skip),
+		s.tickets.Stream(ctx, func(t *pb.Ticket) error {
+			ref("tickets", t.Id, "owner", store.Ref{ID: t.OwnerId, Nombre: t.Owner}, personaIDs, nombres)
+			return nil
+		}, skip),
+		s.proyectos.Stream(ctx, func(p *pb.Proyecto) error {
+			for i, colaborador := range p.Colaboradores {
+				colaboradorID := ""
+				if i < len(p.ColaboradorIds) {
+					colaboradorID = p.ColaboradorIds[i]
+				}
+				ref("proyectos", p.Id, "colaboradores", store.Ref{ID: colaboradorID, Nombre: colaborador}, personaIDs, nombres)
+			}
+			return nil
+		}, skip),
+	)
+	if err != nil {
+		log.Printf("Error al leer las colecciones: %v", err)
+		return nil, streamError(ctx, err, "Error al verificar la integridad")
+	}
+
+	log.Printf("Verificación completa. Referencias rotas: %d, Documentos omitidos: %d", len(resp.Dangling), len(resp.PartialFailures))
+	return resp, nil
+}
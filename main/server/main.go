@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go-grpc-mongo/config"
+	"go-grpc-mongo/db"
+	"go-grpc-mongo/healthcheck"
+	"go-grpc-mongo/lifecycle"
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+	"go-grpc-mongo/validate"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// app reúne el estado compartido entre los componentes del servidor
+type app struct {
+	cfg        *config.Config
+	manager    *lifecycle.Manager
+	client     *mongo.Client
+	store      *store.Store
+	grpcServer *grpc.Server
+	health     *health.Server
+
+	// Cancelación y fin de la creación de índices en segundo plano
+	cancelIndexes context.CancelFunc
+	indexesDone   chan struct{}
+}
+
+// newApp registra los componentes en el orden en que deben iniciarse:
+// logs, almacenamiento (e índices), health y por último el servidor gRPC.
+func newApp(cfg *config.Config) *app {
+	a := &app{cfg: cfg, manager: lifecycle.New(), health: health.NewServer()}
+
+	// El chequeo de health solo depende de la base de datos en el backend mongo
+	var ping func(ctx context.Context) error
+	a.manager.Append(lifecycle.Hook{ComponentName: "logs", OnStop: a.flushLogs})
+	switch cfg.Store {
+	case "mongo":
+		a.manager.Append(lifecycle.Hook{ComponentName: "mongo", OnStart: a.connectMongo, OnStop: a.disconnectMongo})
+		a.manager.Append(lifecycle.Hook{ComponentName: "indexes", OnStart: a.ensureIndexes, OnStop: a.stopIndexes})
+		ping = func(ctx context.Context) error { return db.Ping(ctx, a.client) }
+	case "memory":
+		a.manager.Append(lifecycle.Hook{ComponentName: "memory", OnStart: a.useMemoryStore})
+	}
+	a.manager.Append(healthcheck.New(a.health, ping, cfg.Health.CheckInterval, cfg.Health.CheckTimeout,
+		pb.PersonasService_ServiceDesc.ServiceName,
+		pb.CreateService_ServiceDesc.ServiceName,
+		pb.AdminService_ServiceDesc.ServiceName,
+	))
+	a.manager.Append(lifecycle.Hook{ComponentName: "grpc", OnStart: a.startGRPC, OnStop: a.stopGRPC})
+	return a
+}
+
+// connectMongo no exige que MongoDB responda al iniciar: mientras no esté
+// disponible el servicio de health informa NOT_SERVING.
+func (a *app) connectMongo(ctx context.Context) error {
+	client, err := db.Connect(a.cfg.Mongo)
+	if err != nil {
+		return err
+	}
+	a.client = client
+	a.store = store.NewMongoStore(client.Database(a.cfg.Mongo.Database), store.CollectionNames{
+		Personas:        a.cfg.Mongo.Collections.Personas,
+		Tickets:         a.cfg.Mongo.Collections.Tickets,
+		Proyectos:       a.cfg.Mongo.Collections.Proyectos,
+		IdempotencyKeys: a.cfg.Mongo.Collections.IdempotencyKeys,
+		TicketCounters:  a.cfg.Mongo.Collections.TicketCounters,
+		TicketComments:  a.cfg.Mongo.Collections.TicketComments,
+		TicketHistory:   a.cfg.Mongo.Collections.TicketHistory,
+	})
+	return nil
+}
+
+func (a *app) disconnectMongo(ctx context.Context) error {
+	if err := a.client.Disconnect(ctx); err != nil {
+		return err
+	}
+	log.Println("Conexión a MongoDB cerrada")
+	return nil
+}
+
+// ensureIndexes reconcilia los índices en segundo plano para no demorar el
+// arranque: espera a que MongoDB responda, reintentando en cada intervalo del
+// chequeo de health, y luego crea o recrea los índices una sola vez.
+func (a *app) ensureIndexes(ctx context.Context) error {
+	indexCtx, cancel := context.WithCancel(context.Background())
+	a.cancelIndexes = cancel
+	a.indexesDone = make(chan struct{})
+
+	go func() {
+		defer close(a.indexesDone)
+		ticker := time.NewTicker(a.cfg.Health.CheckInterval)
+		defer ticker.Stop()
+
+		for attempt := 0; ; attempt++ {
+			pingCtx, cancelPing := context.WithTimeout(indexCtx, a.cfg.Health.CheckTimeout)
+			err := db.Ping(pingCtx, a.client)
+			cancelPing()
+			if err == nil {
+				break
+			}
+			if attempt == 0 {
+				log.Printf("MongoDB no responde, los índices se crearán cuando esté disponible: %v", err)
+			}
+			select {
+			case <-indexCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+
+		specs := db.RequiredIndexes(a.cfg.Mongo.Collections)
+		if err := db.EnsureIndexes(indexCtx, a.client.Database(a.cfg.Mongo.Database), specs); err != nil {
+			log.Printf("Error al crear los índices: %v", err)
+			return
+		}
+		log.Printf("Índices verificados: %d", len(specs))
+	}()
+	return nil
+}
+
+// stopIndexes cancela la creación de índices si todavía está en curso
+func (a *app) stopIndexes(ctx context.Context) error {
+	a.cancelIndexes()
+	select {
+	case <-a.indexesDone:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (a *app) useMemoryStore(ctx context.Context) error {
+	log.Println("Usando almacenamiento en memoria: los datos se pierden al detener el servidor")
+	a.store = store.NewMemoryStore()
+	return nil
+}
+
+func (a *app) startGRPC(ctx context.Context) error {
+	lis, err := net.Listen("tcp", a.cfg.ListenAddress)
+	if err != nil {
+		return err
+	}
+
+	a.grpcServer = grpc.NewServer(grpc.UnaryInterceptor(validate.UnaryServerInterceptor(requestRules)))
+	srv := newServer(a.store, a.cfg)
+	pb.RegisterPersonasServiceServer(a.grpcServer, srv)
+	pb.RegisterCreateServiceServer(a.grpcServer, srv)
+	pb.RegisterAdminServiceServer(a.grpcServer, srv)
+	healthpb.RegisterHealthServer(a.grpcServer, a.health)
+	reflection.Register(a.grpcServer)
+
+	warnIntegrityOff(a.cfg.Integrity)
+	log.Printf("Servidor en ejecución en %s", a.cfg.ListenAddress)
+	a.manager.Go("grpc", func() error { return a.grpcServer.Serve(lis) })
+	return nil
+}
+
+// stopGRPC deja de aceptar conexiones y espera a que terminen las solicitudes
+// en curso. Si se vence el plazo, cierra las conexiones abiertas.
+func (a *app) stopGRPC(ctx context.Context) error {
+	// Avisa a los clientes de health que el servidor se está deteniendo
+	a.health.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Println("Servidor gRPC detenido")
+		return nil
+	case <-ctx.Done():
+		a.grpcServer.Stop()
+		return fmt.Errorf("se venció el plazo para terminar las solicitudes en curso: %w", ctx.Err())
+	}
+}
+
+func (a *app) flushLogs(ctx context.Context) error {
+	log.Println("Servidor detenido")
+	// Sync falla en terminales y pipes, donde no hay nada pendiente de escribir
+	os.Stderr.Sync()
+	return nil
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		err := runMigrate(ctx, os.Args[2:])
+		stop()
+		if err != nil && !errors.Is(err, flag.ErrHelp) {
+			log.Fatalf("Error al ejecutar las migraciones: %v", err)
+		}
+		return
+	}
+
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		log.Fatalf("Error al cargar la configuración: %v", err)
+	}
+	if cfg.PrintConfig {
+		out, err := cfg.Dump()
+		if err != nil {
+			log.Fatalf("Error al mostrar la configuración: %v", err)
+		}
+		fmt.Print(out)
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := newApp(cfg).manager.Run(ctx, cfg.ShutdownTimeout); err != nil {
+		log.Fatalf("Error al ejecutar el servidor: %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"go-grpc-mongo/config"
+	"go-grpc-mongo/db"
+	"go-grpc-mongo/migrate"
+)
+
+const migrateUsage = "uso: server migrate [flags] up|down|status|resolve"
+
+// runMigrate implementa el subcomando migrate. Acepta los mismos flags de
+// configuración que el servidor, más -dry-run, -to y -completed, antes del
+// comando.
+func runMigrate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "Muestra lo que haría cada migración sin modificar la base de datos")
+	to := fs.Int("to", -1, "up: última versión a aplicar (por defecto todas); down: versión a la que volver (por defecto revierte solo la última)")
+	completed := fs.Bool("completed", false, "resolve: los pasos que faltaban de la migración a medias se completaron a mano; se registra como aplicada (o revertida)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), migrateUsage)
+		fs.PrintDefaults()
+	}
+
+	cfg, err := config.LoadFlagSet(fs, args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New(migrateUsage)
+	}
+	if cfg.Store != "mongo" {
+		return fmt.Errorf("las migraciones solo se aplican al backend mongo (store=%s)", cfg.Store)
+	}
+
+	client, err := db.ConnectDB(cfg.Mongo)
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(context.Background())
+
+	runner, err := migrate.New(migrate.Target{
+		Database:    client.Database(cfg.Mongo.Database),
+		Collections: cfg.Mongo.Collections,
+	}, migrate.Migrations)
+	if err != nil {
+		return err
+	}
+	runner.DryRun = *dryRun
+
+	switch command := fs.Arg(0); command {
+	case "up":
+		return runner.Up(ctx, max(*to, 0))
+	case "down":
+		return runner.Down(ctx, *to)
+	case "status":
+		return printMigrations(ctx, runner)
+	case "resolve":
+		return resolveMigration(ctx, runner, *completed)
+	default:
+		return fmt.Errorf("comando desconocido %q; %s", command, migrateUsage)
+	}
+}
+
+// printMigrations muestra cada migración conocida y si está aplicada
+func printMigrations(ctx context.Context, runner *migrate.Runner) error {
+	statuses, err := runner.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSIÓN\tNOMBRE\tAPLICADA")
+	for _, s := range statuses {
+		applied := "pendiente"
+		if !s.AppliedAt.IsZero() {
+			applied = s.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", s.Migration.Version, s.Migration.Name, applied)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	failure, err := runner.Failure(ctx)
+	if err != nil {
+		return err
+	}
+	if failure != nil {
+		fmt.Printf("\nA MEDIAS: %v\n", failure)
+	}
+	return nil
+}
+
+// resolveMigration borra el registro de una migración que quedó a medias, una
+// vez que se revisaron los datos, e informa qué hará el próximo up o down
+func resolveMigration(ctx context.Context, runner *migrate.Runner, completed bool) error {
+	failure, err := runner.Resolve(ctx, completed)
+	if err != nil {
+		return err
+	}
+	if failure == nil {
+		fmt.Println("No hay migraciones a medias")
+		return nil
+	}
+	fmt.Printf("Registro borrado: migración %d (%s), %d de %d pasos completos\n", failure.Version, failure.Name, failure.Applied, failure.Steps)
+	switch {
+	case completed && failure.Up:
+		fmt.Println("La migración quedó registrada como aplicada")
+	case completed:
+		fmt.Println("La migración quedó registrada como revertida")
+	default:
+		command := "up"
+		if !failure.Up {
+			command = "down"
+		}
+		fmt.Printf("El próximo migrate %s vuelve a ejecutar la migración %d desde el paso 1, incluidos los %d pasos que ya se completaron. "+
+			"Si no se deshicieron, usar migrate -completed resolve después de completar los que faltan.\n", command, failure.Version, failure.Applied)
+	}
+	return nil
+}
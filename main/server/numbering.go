@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+
+	"go-grpc-mongo/config"
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxNumberAttempts limita cuántos números ocupados se saltean al numerar un
+// ticket. Los ocupa un ticket importado con número propio o anterior al
+// contador (ver la migración contador_de_tickets).
+const maxNumberAttempts = 100
+
+// ticketSequence devuelve el nombre y el primer número de la secuencia pedida;
+// vacío es la secuencia por defecto
+func (s *server) ticketSequence(name string) (string, int32, error) {
+	if name == "" {
+		name = config.DefaultTicketSequence
+	}
+	first, ok := s.cfg.Tickets.Sequences[name]
+	if !ok {
+		return "", 0, status.Errorf(codes.InvalidArgument, "La secuencia %q no existe (secuencias configuradas: %s)",
+			name, strings.Join(slices.Sorted(maps.Keys(s.cfg.Tickets.Sequences)), ", "))
+	}
+	return name, first, nil
+}
+
+// insertTicket crea el ticket y lo agrega a los tickets de su dueño en una
+// transacción, si la base la admite. Cada intento de createNumbered usa su
+// propia transacción: en MongoDB un número repetido aborta la transacción.
+func (s *server) insertTicket(ctx context.Context, ticket *pb.Ticket) (string, error) {
+	var id string
+	err := s.unitOfWork.RunIfSupported(ctx, func(ctx context.Context) error {
+		var err error
+		if id, err = s.tickets.Create(ctx, ticket); err != nil {
+			return err
+		}
+		_, err = s.syncOwner(ctx, ticket, ticket)
+		return err
+	})
+	return id, err
+}
+
+// createNumbered crea el ticket con el siguiente número libre de la secuencia.
+// Los números ocupados se saltean y los asignados no se reutilizan, aunque la
+// creación falle.
+func (s *server) createNumbered(ctx context.Context, ticket *pb.Ticket, sequence string, first int32) (string, error) {
+	for i := 0; i < maxNumberAttempts; i++ {
+		numero, err := s.counters.Next(ctx, sequence, first)
+		if err != nil {
+			return "", err
+		}
+		ticket.TicketNumero = numero
+		id, err := s.insertTicket(ctx, ticket)
+		if !errors.Is(err, store.ErrAlreadyExists) {
+			return id, err
+		}
+		log.Printf("El número %d de la secuencia %s ya está en uso, se prueba el siguiente", numero, sequence)
+	}
+	return "", status.Errorf(codes.ResourceExhausted, "No se encontró un número libre en la secuencia %s después de %d intentos", sequence, maxNumberAttempts)
+}
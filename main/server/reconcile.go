@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+)
+
+// expectedTickets devuelve la lista de tickets que debería tener una persona:
+// conserva el orden de los que ya tenía y agrega al final los que faltan, de
+// menor a mayor. Con onlyAdd no quita los números que no son de la persona,
+// solo los repetidos.
+func expectedTickets(current []int32, owned map[int32]bool, onlyAdd bool) (tickets, added, removed []int32) {
+	kept := map[int32]bool{}
+	for _, numero := range current {
+		if (!owned[numero] && !onlyAdd) || kept[numero] {
+			removed = append(removed, numero)
+			continue
+		}
+		kept[numero] = true
+		tickets = append(tickets, numero)
+	}
+	for numero := range owned {
+		if !kept[numero] {
+			added = append(added, numero)
+		}
+	}
+	slices.Sort(added)
+	return append(tickets, added...), added, removed
+}
+
+// ReconcileTicketOwners - Recorre personas y tickets y corrige personas.tickets
+// para que coincida con el owner de cada ticket, que es el dato que manda. La
+// primera pasada arma los dueños de cada número y la segunda vuelve a leer las
+// personas y corrige las que no coinciden, así que la memoria usada depende de
+// la cantidad de tickets y no del tamaño de los documentos. Los documentos que
+// no se pueden decodificar se informan en partial_failures y el recorrido sigue.
+func (s *server) ReconcileTicketOwners(ctx context.Context, req *pb.ReconcileTicketOwnersRequest) (*pb.ReconcileTicketOwnersResponse, error) {
+	log.Printf("Iniciando la reconciliación de dueños de tickets. DryRun=%t", req.DryRun)
+
+	resp := &pb.ReconcileTicketOwnersResponse{}
+	ticketFailures := 0
+	failed := func(collection string) func(store.DecodeFailure) error {
+		return func(failure store.DecodeFailure) error {
+			log.Printf("Documento de %s omitido porque no se pudo leer: ID=%s: %v", collection, failure.ID, failure.Err)
+			resp.PartialFailures = append(resp.PartialFailures, partialFailure(collection, failure))
+			if collection == "tickets" {
+				ticketFailures++
+			}
+			return nil
+		}
+	}
+
+	// Los tickets anteriores a las referencias por ID se asignan por nombre;
+	// si hay nombres repetidos se usa la primera persona
+	personaIDs, porNombre := map[string]bool{}, map[string]string{}
+	owned := map[string]map[int32]bool{}
+	err := s.personas.Stream(ctx, func(p *pb.Persona) error {
+		resp.Personas++
+		personaIDs[p.Id] = true
+		if _, ok := porNombre[p.Nombre]; !ok {
+			porNombre[p.Nombre] = p.Id
+		}
+		return nil
+	}, failed("personas"))
+	if err == nil {
+		err = s.tickets.Stream(ctx, func(t *pb.Ticket) error {
+			resp.Tickets++
+			owner := t.OwnerId
+			if owner == "" {
+				owner = porNombre[t.Owner]
+			}
+			if owner == "" || !personaIDs[owner] {
+				return nil
+			}
+			if owned[owner] == nil {
+				owned[owner] = map[int32]bool{}
+			}
+			owned[owner][t.TicketNumero] = true
+			return nil
+		}, failed("tickets"))
+	}
+	if err != nil {
+		log.Printf("Error al leer las colecciones: %v", err)
+		return nil, streamError(ctx, err, "Error al reconciliar los dueños de los tickets")
+	}
+
+	// Un número que no aparece en owned puede ser de un ticket que no se pudo
+	// leer, así que en ese caso solo se agregan los que faltan
+	onlyAdd := ticketFailures > 0
+	if onlyAdd {
+		log.Printf("Hay %d tickets que no se pudieron leer: no se quitan números de las listas", ticketFailures)
+	}
+	var updateErr error
+	err = s.personas.Stream(ctx, func(p *pb.Persona) error {
+		expected, added, removed := expectedTickets(p.Tickets, owned[p.Id], onlyAdd)
+		if len(added) == 0 && len(removed) == 0 {
+			return nil
+		}
+
+		if !req.DryRun {
+			// La versión es la que se leyó: si la persona cambió mientras
+			// tanto no se pisa y se informa como conflicto
+			p.Tickets = expected
+			err := s.personas.Update(ctx, p, []string{"tickets"})
+			var mismatch *store.VersionMismatchError
+			if errors.As(err, &mismatch) || errors.Is(err, store.ErrNotFound) {
+				log.Printf("La persona %s cambió durante la reconciliación: %v", p.Id, err)
+				resp.Conflicts++
+				return nil
+			}
+			if err != nil {
+				log.Printf("Error al actualizar los tickets de la persona %s: %v", p.Id, err)
+				updateErr = err
+				return err
+			}
+		}
+		resp.Repairs = append(resp.Repairs, &pb.TicketListRepair{PersonaId: p.Id, Persona: p.Nombre, Added: added, Removed: removed})
+		return nil
+	}, func(store.DecodeFailure) error { return nil })
+	switch {
+	case updateErr != nil:
+		return nil, storeError(updateErr, "", "Error al reconciliar los dueños de los tickets")
+	case err != nil:
+		log.Printf("Error al leer las personas: %v", err)
+		return nil, streamError(ctx, err, "Error al reconciliar los dueños de los tickets")
+	}
+
+	log.Printf("Reconciliación completa. Personas corregidas: %d, Conflictos: %d, Documentos omitidos: %d",
+		len(resp.Repairs), resp.Conflicts, len(resp.PartialFailures))
+	return resp, nil
+}
@@ -0,0 +1,381 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Las referencias a personas y proyectos se guardan por ID junto con una copia
+// del nombre, que es lo que se muestra. Los clientes pueden seguir enviando
+// solo el nombre: al escribir se busca el ID, y al leer se completa el nombre
+// actual a partir del ID (o el ID a partir del nombre en los documentos
+// anteriores a las referencias por ID).
+
+func personaRef(p *pb.Persona) store.Ref {
+	return store.Ref{ID: p.Id, Nombre: p.Nombre}
+}
+
+func proyectoRef(p *pb.Proyecto) store.Ref {
+	return store.Ref{ID: p.Id, Nombre: p.Nombre}
+}
+
+func (s *server) personaByID(ctx context.Context, id string) (store.Ref, error) {
+	p, err := s.personas.Get(ctx, id)
+	if err != nil {
+		return store.Ref{}, err
+	}
+	return personaRef(p), nil
+}
+
+func (s *server) personaByNombre(ctx context.Context, nombre string) (store.Ref, error) {
+	p, err := s.personas.GetByNombre(ctx, nombre)
+	if err != nil {
+		return store.Ref{}, err
+	}
+	return personaRef(p), nil
+}
+
+func (s *server) proyectoByID(ctx context.Context, id string) (store.Ref, error) {
+	p, err := s.proyectos.Get(ctx, id)
+	if err != nil {
+		return store.Ref{}, err
+	}
+	return proyectoRef(p), nil
+}
+
+func (s *server) proyectoByNombre(ctx context.Context, nombre string) (store.Ref, error) {
+	p, err := s.proyectos.GetByNombre(ctx, nombre)
+	if err != nil {
+		return store.Ref{}, err
+	}
+	return proyectoRef(p), nil
+}
+
+// resolveRef completa una referencia enviada por el cliente. Si tiene ID se
+// busca el documento y se toma su nombre; si solo tiene nombre se busca el ID.
+// Devuelve false si el documento no existe, y en ese caso la referencia queda
+// como se envió. field es el campo con el nombre, para el mensaje de error si
+// el ID y el nombre se refieren a documentos distintos.
+func resolveRef(ctx context.Context, ref store.Ref, field string,
+	byID, byNombre func(context.Context, string) (store.Ref, error)) (store.Ref, bool, error) {
+	var found store.Ref
+	var err error
+	switch {
+	case ref.ID != "":
+		found, err = byID(ctx, ref.ID)
+	case ref.Nombre != "":
+		found, err = byNombre(ctx, ref.Nombre)
+	default:
+		return ref, true, nil
+	}
+
+	switch {
+	case errors.Is(err, store.ErrNotFound), errors.Is(err, store.ErrInvalidID):
+		return ref, false, nil
+	case err != nil:
+		return ref, false, err
+	case ref.Nombre != "" && ref.Nombre != found.Nombre:
+		return ref, false, status.Errorf(codes.InvalidArgument, "%s %q no corresponde a %s %q, que se llama %q",
+			idField(field), ref.ID, field, ref.Nombre, found.Nombre)
+	}
+	return found, true, nil
+}
+
+// idField devuelve el campo con el ID que corresponde al campo con el nombre
+func idField(field string) string {
+	if id, ok := store.ReferenceFields[field]; ok {
+		return id
+	}
+	return field + "_id"
+}
+
+// refKey identifica la referencia en las violaciones: el ID si lo tiene, si no
+// el nombre
+func refKey(ref store.Ref) string {
+	if ref.ID != "" {
+		return ref.ID
+	}
+	return ref.Nombre
+}
+
+// referenceFields agrega a fields el otro campo de cada referencia presente:
+// el nombre y el ID se escriben siempre juntos
+func referenceFields(fields []string) []string {
+	for name, id := range store.ReferenceFields {
+		hasName, hasID := slices.Contains(fields, name), slices.Contains(fields, id)
+		switch {
+		case hasName && !hasID:
+			fields = append(fields, id)
+		case hasID && !hasName:
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// resolvePersonaReferences completa el ID y el nombre del proyecto de la
+// persona y, si la validación está activa, verifica que el proyecto exista,
+// solo si se va a escribir. Los tickets de la persona no se escriben desde
+// las solicitudes (ver ticketsReadOnly).
+func (s *server) resolvePersonaReferences(ctx context.Context, persona *pb.Persona, fields []string) error {
+	validate := s.cfg.Integrity.ValidateReferences
+
+	var violations []*errdetails.PreconditionFailure_Violation
+	if slices.Contains(fields, "proyecto") {
+		ref, ok, err := resolveRef(ctx, store.Ref{ID: persona.ProyectoId, Nombre: persona.Proyecto}, "proyecto", s.proyectoByID, s.proyectoByNombre)
+		if err != nil {
+			return txError(err, "", "Error al verificar el proyecto de la persona")
+		}
+		if !ok && validate {
+			violations = append(violations, missingReference("proyectos", refKey(ref), fmt.Sprintf("El proyecto %s no existe", refKey(ref))))
+		}
+		persona.ProyectoId, persona.Proyecto = ref.ID, ref.Nombre
+	}
+	return referenceError(violations)
+}
+
+// resolveTicketReferences completa el ID y el nombre del dueño del ticket y, si
+// la validación está activa, verifica que exista
+func (s *server) resolveTicketReferences(ctx context.Context, ticket *pb.Ticket, fields []string) error {
+	if !slices.Contains(fields, "owner") {
+		return nil
+	}
+
+	ref, ok, err := resolveRef(ctx, store.Ref{ID: ticket.OwnerId, Nombre: ticket.Owner}, "owner", s.personaByID, s.personaByNombre)
+	if err != nil {
+		return txError(err, "", "Error al verificar el dueño del ticket")
+	}
+	if !ok && s.cfg.Integrity.ValidateReferences {
+		return referenceError([]*errdetails.PreconditionFailure_Violation{
+			missingReference("personas", refKey(ref), fmt.Sprintf("La persona %s no existe", refKey(ref))),
+		})
+	}
+	ticket.OwnerId, ticket.Owner = ref.ID, ref.Nombre
+	return nil
+}
+
+// resolveProyectoReferences completa los IDs y los nombres de los
+// colaboradores del proyecto, en el mismo orden, y si la validación está
+// activa verifica que existan. Los colaboradores que no existen quedan con ID
+// vacío.
+func (s *server) resolveProyectoReferences(ctx context.Context, proyecto *pb.Proyecto, fields []string) error {
+	if !slices.Contains(fields, "colaboradores") {
+		return nil
+	}
+
+	ids, nombres := proyecto.ColaboradorIds, proyecto.Colaboradores
+	if len(ids) > 0 && len(nombres) > 0 && len(ids) != len(nombres) {
+		return status.Error(codes.InvalidArgument, "colaboradores y colaborador_ids deben tener la misma cantidad de elementos")
+	}
+
+	refs := make([]store.Ref, max(len(ids), len(nombres)))
+	var violations []*errdetails.PreconditionFailure_Violation
+	for i := range refs {
+		ref := store.Ref{}
+		if i < len(ids) {
+			ref.ID = ids[i]
+		}
+		if i < len(nombres) {
+			ref.Nombre = nombres[i]
+		}
+		ref, ok, err := resolveRef(ctx, ref, "colaboradores", s.personaByID, s.personaByNombre)
+		if err != nil {
+			return txError(err, "", "Error al verificar los colaboradores del proyecto")
+		}
+		if !ok && s.cfg.Integrity.ValidateReferences && !slices.Contains(refs[:i], ref) {
+			violations = append(violations, missingReference("personas", refKey(ref), fmt.Sprintf("La persona %s no existe", refKey(ref))))
+		}
+		refs[i] = ref
+	}
+	if err := referenceError(violations); err != nil {
+		return err
+	}
+
+	proyecto.ColaboradorIds = make([]string, len(refs))
+	proyecto.Colaboradores = make([]string, len(refs))
+	for i, ref := range refs {
+		proyecto.ColaboradorIds[i], proyecto.Colaboradores[i] = ref.ID, ref.Nombre
+	}
+	return nil
+}
+
+// refResolver completa las referencias de los documentos que se devuelven: el
+// nombre actual a partir del ID y, en los documentos que solo tienen el
+// nombre, el ID. Guarda lo que ya buscó para no repetir consultas en una misma
+// respuesta.
+type refResolver struct {
+	s         *server
+	personas  refCache
+	proyectos refCache
+}
+
+// refCache guarda los documentos ya buscados por ID y por nombre; un valor
+// vacío indica que no existe
+type refCache struct {
+	byID     map[string]store.Ref
+	byNombre map[string]store.Ref
+}
+
+func (s *server) newRefResolver() *refResolver {
+	return &refResolver{
+		s:         s,
+		personas:  refCache{byID: map[string]store.Ref{}, byNombre: map[string]store.Ref{}},
+		proyectos: refCache{byID: map[string]store.Ref{}, byNombre: map[string]store.Ref{}},
+	}
+}
+
+// load busca de una vez los IDs que todavía no están en la cache
+func (c *refCache) load(ctx context.Context, ids []string, list func(context.Context, []string) ([]store.Ref, error)) error {
+	var missing []string
+	for _, id := range ids {
+		if _, ok := c.byID[id]; !ok && id != "" && !slices.Contains(missing, id) {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	refs, err := list(ctx, missing)
+	if err != nil {
+		return err
+	}
+	for _, id := range missing {
+		c.byID[id] = store.Ref{}
+	}
+	for _, ref := range refs {
+		c.byID[ref.ID] = ref
+	}
+	return nil
+}
+
+// resolve completa ref con la cache, buscando por nombre si no tiene ID. Si
+// el documento no existe la referencia queda como está.
+func (c *refCache) resolve(ctx context.Context, ref store.Ref, byNombre func(context.Context, string) (store.Ref, error)) (store.Ref, error) {
+	if ref.ID != "" {
+		if found := c.byID[ref.ID]; found != (store.Ref{}) {
+			return found, nil
+		}
+		return ref, nil
+	}
+	if ref.Nombre == "" {
+		return ref, nil
+	}
+
+	found, ok := c.byNombre[ref.Nombre]
+	if !ok {
+		var err error
+		found, err = byNombre(ctx, ref.Nombre)
+		if errors.Is(err, store.ErrNotFound) {
+			err = nil
+		}
+		if err != nil {
+			return ref, err
+		}
+		c.byNombre[ref.Nombre] = found
+	}
+	if found != (store.Ref{}) {
+		return found, nil
+	}
+	return ref, nil
+}
+
+func (r *refResolver) listPersonas(ctx context.Context, ids []string) ([]store.Ref, error) {
+	personas, err := r.s.personas.ListByIDs(ctx, ids)
+	refs := make([]store.Ref, len(personas))
+	for i, p := range personas {
+		refs[i] = personaRef(p)
+	}
+	return refs, err
+}
+
+func (r *refResolver) listProyectos(ctx context.Context, ids []string) ([]store.Ref, error) {
+	proyectos, err := r.s.proyectos.ListByIDs(ctx, ids)
+	refs := make([]store.Ref, len(proyectos))
+	for i, p := range proyectos {
+		refs[i] = proyectoRef(p)
+	}
+	return refs, err
+}
+
+// Personas completa el proyecto de las personas
+func (r *refResolver) Personas(ctx context.Context, personas ...*pb.Persona) error {
+	ids := make([]string, len(personas))
+	for i, p := range personas {
+		ids[i] = p.ProyectoId
+	}
+	if err := r.proyectos.load(ctx, ids, r.listProyectos); err != nil {
+		return err
+	}
+
+	for _, p := range personas {
+		ref, err := r.proyectos.resolve(ctx, store.Ref{ID: p.ProyectoId, Nombre: p.Proyecto}, r.s.proyectoByNombre)
+		if err != nil {
+			return err
+		}
+		p.ProyectoId, p.Proyecto = ref.ID, ref.Nombre
+	}
+	return nil
+}
+
+// Tickets completa el dueño de los tickets
+func (r *refResolver) Tickets(ctx context.Context, tickets ...*pb.Ticket) error {
+	ids := make([]string, len(tickets))
+	for i, t := range tickets {
+		ids[i] = t.OwnerId
+	}
+	if err := r.personas.load(ctx, ids, r.listPersonas); err != nil {
+		return err
+	}
+
+	for _, t := range tickets {
+		ref, err := r.personas.resolve(ctx, store.Ref{ID: t.OwnerId, Nombre: t.Owner}, r.s.personaByNombre)
+		if err != nil {
+			return err
+		}
+		t.OwnerId, t.Owner = ref.ID, ref.Nombre
+	}
+	return nil
+}
+
+// Proyectos completa los colaboradores de los proyectos, con colaborador_ids
+// y colaboradores del mismo largo
+func (r *refResolver) Proyectos(ctx context.Context, proyectos ...*pb.Proyecto) error {
+	var ids []string
+	for _, p := range proyectos {
+		ids = append(ids, p.ColaboradorIds...)
+	}
+	if err := r.personas.load(ctx, ids, r.listPersonas); err != nil {
+		return err
+	}
+
+	for _, p := range proyectos {
+		n := max(len(p.ColaboradorIds), len(p.Colaboradores))
+		ids, nombres := make([]string, n), make([]string, n)
+		for i := range n {
+			ref := store.Ref{}
+			if i < len(p.ColaboradorIds) {
+				ref.ID = p.ColaboradorIds[i]
+			}
+			if i < len(p.Colaboradores) {
+				ref.Nombre = p.Colaboradores[i]
+			}
+			ref, err := r.personas.resolve(ctx, ref, r.s.personaByNombre)
+			if err != nil {
+				return err
+			}
+			ids[i], nombres[i] = ref.ID, ref.Nombre
+		}
+		p.ColaboradorIds, p.Colaboradores = ids, nombres
+	}
+	return nil
+}
@@ -2,238 +2,390 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
-	"net"
+	"slices"
+	"strconv"
+	"strings"
 
-	"go-grpc-mongo/db" // Importa el paquete db
+	"go-grpc-mongo/config"
 	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/query"
+	"go-grpc-mongo/store"
 
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
-	"google.golang.org/grpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
-var client *mongo.Client
-
 type server struct {
 	pb.UnimplementedPersonasServiceServer
 	pb.UnimplementedCreateServiceServer
+	pb.UnimplementedAdminServiceServer
+
+	personas    store.PersonaRepository
+	tickets     store.TicketRepository
+	proyectos   store.ProyectoRepository
+	idempotency store.IdempotencyRepository
+	references  store.ReferenceRepository
+	counters    store.CounterRepository
+	comments    store.TicketCommentRepository
+	history     store.TicketHistoryRepository
+	unitOfWork  store.UnitOfWork
+
+	cfg *config.Config
 }
 
-// GetPersonas - Maneja la solicitud para obtener todas las personas
-func (s *server) GetPersonas(ctx context.Context, req *pb.GetPersonasRequest) (*pb.GetPersonasResponse, error) {
-	log.Println("Iniciando la consulta para obtener todas las personas.")
-	collection := client.Database("argentina_office").Collection("personas")
+// newServer crea el servidor gRPC con los repositorios que usarán los handlers
+func newServer(st *store.Store, cfg *config.Config) *server {
+	return &server{
+		personas:    st.Personas,
+		tickets:     st.Tickets,
+		proyectos:   st.Proyectos,
+		idempotency: st.Idempotency,
+		references:  st.References,
+		counters:    st.Counters,
+		comments:    st.Comments,
+		history:     st.History,
+		unitOfWork:  st.UnitOfWork,
+		cfg:         cfg,
+	}
+}
+
+// page arma la paginación pedida aplicando el tamaño por defecto y el máximo del servidor
+func (s *server) page(pageSize int32, pageToken string) (store.Page, error) {
+	switch {
+	case pageSize < 0:
+		return store.Page{}, status.Error(codes.InvalidArgument, "page_size no puede ser negativo")
+	case pageSize == 0:
+		pageSize = s.cfg.Pagination.DefaultPageSize
+	case pageSize > s.cfg.Pagination.MaxPageSize:
+		pageSize = s.cfg.Pagination.MaxPageSize
+	}
+	return store.Page{Size: pageSize, Token: pageToken}, nil
+}
 
-	cursor, err := collection.Find(ctx, bson.M{})
+// parseQuery valida filter y order_by contra los campos permitidos de la entidad
+func parseQuery(filter, orderBy string, schema query.Schema) (*query.Query, error) {
+	q, err := query.Parse(filter, orderBy, schema)
 	if err != nil {
-		log.Printf("Error al conectar a la base de datos para obtener personas: %v", err)
-		return nil, err
+		log.Printf("Filtro u orden inválido (filter=%q, order_by=%q): %v", filter, orderBy, err)
+		return nil, status.Errorf(codes.InvalidArgument, "filter/order_by inválido: %v", err)
+	}
+	return q, nil
+}
+
+// updateFields valida update_mask contra los campos que se pueden actualizar.
+// Una máscara vacía o "*" reemplaza todos los campos.
+func updateFields(mask *fieldmaskpb.FieldMask, allowed []string) ([]string, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 || (len(paths) == 1 && paths[0] == "*") {
+		return allowed, nil
 	}
-	defer cursor.Close(ctx)
 
-	var resultado []*pb.Persona
-	for cursor.Next(ctx) {
-		var persona struct {
-			ID       string  `bson:"_id"`
-			Nombre   string  `bson:"nombre"`
-			Edad     int32   `bson:"edad"`
-			Tickets  []int32 `bson:"tickets"`
-			Proyecto string  `bson:"proyecto"`
+	fields := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if path == "*" {
+			return nil, status.Error(codes.InvalidArgument, "update_mask: \"*\" no se puede combinar con otros campos")
 		}
-		if err := cursor.Decode(&persona); err != nil {
-			log.Printf("Error al decodificar persona: %v", err)
-			return nil, err
+		if !slices.Contains(allowed, path) {
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask: el campo %q no se puede actualizar (campos permitidos: %s)",
+				path, strings.Join(allowed, ", "))
 		}
+		if !slices.Contains(fields, path) {
+			fields = append(fields, path)
+		}
+	}
+	return fields, nil
+}
 
-		resultado = append(resultado, &pb.Persona{
-			Id:       persona.ID,
-			Nombre:   persona.Nombre,
-			Edad:     persona.Edad,
-			Tickets:  persona.Tickets,
-			Proyecto: persona.Proyecto,
-		})
-		log.Printf("Persona encontrada: ID=%s, Nombre=%s, Edad=%d", persona.ID, persona.Nombre, persona.Edad)
+// versionError informa la versión actual del documento en el mensaje y en un
+// ErrorInfo, para que el cliente pueda releer y reintentar
+func versionError(current int64) error {
+	st := status.Newf(codes.FailedPrecondition, "La versión no coincide: la versión actual es %d", current)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "VERSION_MISMATCH",
+		Domain:   "go-grpc-mongo",
+		Metadata: map[string]string{"current_version": strconv.FormatInt(current, 10)},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// storeError traduce los errores del store a errores gRPC
+func storeError(err error, notFound string, internal string) error {
+	var mismatch *store.VersionMismatchError
+	switch {
+	case errors.As(err, &mismatch):
+		return versionError(mismatch.Current)
+	case errors.Is(err, store.ErrNotFound):
+		return status.Error(codes.NotFound, notFound)
+	case errors.Is(err, store.ErrInvalidID):
+		return status.Error(codes.InvalidArgument, "ID inválido")
+	case errors.Is(err, store.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, "page_token inválido")
+	case errors.Is(err, store.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, "Ya existe un documento con el mismo valor en un campo único")
+	case errors.Is(err, store.ErrTransactionsUnsupported):
+		return status.Error(codes.Unimplemented, "La base de datos no admite transacciones: MongoDB debe ejecutarse como replica set")
+	default:
+		return status.Error(codes.Internal, internal)
 	}
+}
 
-	if err := cursor.Err(); err != nil {
-		log.Printf("Error en el cursor al iterar sobre personas: %v", err)
+// GetPersonas - Maneja la solicitud para obtener todas las personas
+func (s *server) GetPersonas(ctx context.Context, req *pb.GetPersonasRequest) (*pb.GetPersonasResponse, error) {
+	log.Println("Iniciando la consulta para obtener todas las personas.")
+
+	page, err := s.page(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	q, err := parseQuery(req.Filter, req.OrderBy, store.PersonaFields)
+	if err != nil {
 		return nil, err
 	}
 
+	resultado, nextPageToken, err := s.personas.List(ctx, q, page)
+	if err != nil {
+		log.Printf("Error al obtener personas: %v", err)
+		return nil, storeError(err, "", "Error al obtener personas")
+	}
+	if err := s.newRefResolver().Personas(ctx, resultado...); err != nil {
+		log.Printf("Error al resolver las referencias de las personas: %v", err)
+		return nil, storeError(err, "", "Error al obtener personas")
+	}
+	for _, persona := range resultado {
+		log.Printf("Persona encontrada: ID=%s, Nombre=%s, Edad=%d", persona.Id, persona.Nombre, persona.Edad)
+	}
+
 	log.Println("Consulta completa. Enviando lista de personas.")
-	return &pb.GetPersonasResponse{Personas: resultado}, nil
+	return &pb.GetPersonasResponse{Personas: resultado, NextPageToken: nextPageToken}, nil
 }
 
 // GetTickets - Maneja la solicitud para obtener todos los tickets
 func (s *server) GetTickets(ctx context.Context, req *pb.GetTicketsRequest) (*pb.GetTicketsResponse, error) {
 	log.Println("Iniciando la consulta para obtener todos los tickets.")
-	collection := client.Database("argentina_office").Collection("tickets")
 
-	cursor, err := collection.Find(ctx, bson.M{})
+	page, err := s.page(req.PageSize, req.PageToken)
 	if err != nil {
-		log.Printf("Error al conectar a la base de datos para obtener tickets: %v", err)
 		return nil, err
 	}
-	defer cursor.Close(ctx)
-
-	var resultado []*pb.Ticket
-	for cursor.Next(ctx) {
-		var ticket struct {
-			ID           string `bson:"_id"`
-			TicketNumero int32  `bson:"ticket_numero"`
-			Owner        string `bson:"owner"`
-		}
-		if err := cursor.Decode(&ticket); err != nil {
-			log.Printf("Error al decodificar ticket: %v", err)
-			return nil, err
-		}
-
-		resultado = append(resultado, &pb.Ticket{
-			Id:           ticket.ID,
-			TicketNumero: ticket.TicketNumero,
-			Owner:        ticket.Owner,
-		})
-		log.Printf("Ticket encontrado: ID=%s, Número=%d, Propietario=%s", ticket.ID, ticket.TicketNumero, ticket.Owner)
+	q, err := parseQuery(req.Filter, req.OrderBy, store.TicketFields)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := cursor.Err(); err != nil {
-		log.Printf("Error en el cursor al iterar sobre tickets: %v", err)
-		return nil, err
+	resultado, nextPageToken, err := s.tickets.List(ctx, q, page)
+	if err != nil {
+		log.Printf("Error al obtener tickets: %v", err)
+		return nil, storeError(err, "", "Error al obtener tickets")
+	}
+	if err := s.newRefResolver().Tickets(ctx, resultado...); err != nil {
+		log.Printf("Error al resolver las referencias de los tickets: %v", err)
+		return nil, storeError(err, "", "Error al obtener tickets")
+	}
+	for _, ticket := range resultado {
+		log.Printf("Ticket encontrado: ID=%s, Número=%d, Propietario=%s", ticket.Id, ticket.TicketNumero, ticket.Owner)
 	}
 
 	log.Println("Consulta completa. Enviando lista de tickets.")
-	return &pb.GetTicketsResponse{Tickets: resultado}, nil
+	return &pb.GetTicketsResponse{Tickets: resultado, NextPageToken: nextPageToken}, nil
 }
 
 // GetProyectos - Maneja la solicitud para obtener todos los proyectos
 func (s *server) GetProyectos(ctx context.Context, req *pb.GetProyectosRequest) (*pb.GetProyectosResponse, error) {
 	log.Println("Iniciando la consulta para obtener todos los proyectos.")
-	collection := client.Database("argentina_office").Collection("proyectos")
 
-	var proyectos []bson.M
-	cursor, err := collection.Find(ctx, bson.M{})
+	page, err := s.page(req.PageSize, req.PageToken)
 	if err != nil {
-		log.Printf("Error al conectar a la base de datos para obtener proyectos: %v", err)
 		return nil, err
 	}
-	defer cursor.Close(ctx)
-
-	if err := cursor.All(ctx, &proyectos); err != nil {
-		log.Fatalf("Error al decodificar proyectos como bson.M: %v", err)
+	q, err := parseQuery(req.Filter, req.OrderBy, store.ProyectoFields)
+	if err != nil {
+		return nil, err
 	}
-	log.Printf("Total de proyectos encontrados: %d", len(proyectos))
 
-	var resultado []*pb.Proyecto
-	for _, proyecto := range proyectos {
-		resultado = append(resultado, &pb.Proyecto{
-			Id:              proyecto["_id"].(primitive.ObjectID).Hex(),
-			Nombre:          proyecto["nombre"].(string),
-			Colaboradores:   convertToStringArray(proyecto["colaboradores"]),
-			NivelDificultad: proyecto["nivel_dificultad"].(string),
-		})
-		log.Printf("Proyecto encontrado: ID=%s, Nombre=%s, Dificultad=%s", resultado[len(resultado)-1].Id, resultado[len(resultado)-1].Nombre, resultado[len(resultado)-1].NivelDificultad)
+	resultado, nextPageToken, failures, err := s.proyectos.List(ctx, q, page)
+	if err != nil {
+		log.Printf("Error al obtener proyectos: %v", err)
+		return nil, storeError(err, "", "Error al obtener proyectos")
+	}
+	partialFailures := make([]*pb.PartialFailure, len(failures))
+	for i, failure := range failures {
+		log.Printf("Proyecto omitido porque no se pudo leer: ID=%s: %v", failure.ID, failure.Err)
+		partialFailures[i] = partialFailure("proyectos", failure)
+	}
+	if req.Strict && len(failures) > 0 {
+		return nil, decodeError(failures)
+	}
+	if err := s.newRefResolver().Proyectos(ctx, resultado...); err != nil {
+		log.Printf("Error al resolver las referencias de los proyectos: %v", err)
+		return nil, storeError(err, "", "Error al obtener proyectos")
+	}
+	log.Printf("Total de proyectos encontrados: %d", len(resultado))
+	for _, proyecto := range resultado {
+		log.Printf("Proyecto encontrado: ID=%s, Nombre=%s, Dificultad=%s", proyecto.Id, proyecto.Nombre, proyecto.Dificultad)
 	}
 
 	log.Println("Consulta completa. Enviando lista de proyectos.")
-	return &pb.GetProyectosResponse{Proyectos: resultado}, nil
+	return &pb.GetProyectosResponse{Proyectos: resultado, NextPageToken: nextPageToken, PartialFailures: partialFailures}, nil
+}
+
+// decodeError devuelve INTERNAL con los IDs de los documentos que no se
+// pudieron leer en un ErrorInfo, para las consultas en modo strict
+func decodeError(failures []store.DecodeFailure) error {
+	ids := make([]string, len(failures))
+	for i, failure := range failures {
+		ids[i] = failure.ID
+	}
+	st := status.Newf(codes.Internal, "No se pudieron leer %d documentos (el primero, %s: %v)", len(failures), failures[0].ID, failures[0].Err)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "MALFORMED_DOCUMENT",
+		Domain:   "go-grpc-mongo",
+		Metadata: map[string]string{"ids": strings.Join(ids, ",")},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// partialFailure describe un documento de collection que no se pudo decodificar
+func partialFailure(collection string, failure store.DecodeFailure) *pb.PartialFailure {
+	return &pb.PartialFailure{Id: failure.ID, Reason: failure.Err.Error(), Collection: collection}
+}
+
+// streamError traduce el error de un streaming: si el cliente canceló o se
+// venció el plazo devuelve ese código, si no un error interno
+func streamError(ctx context.Context, err error, internal string) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, internal)
 }
 
-// convertToStringArray - Convierte la interfaz de MongoDB a []string
-func convertToStringArray(data interface{}) []string {
-	array, ok := data.([]interface{})
-	if !ok {
-		return []string{}
+// StreamPersonas - Envía todas las personas de a una, a medida que se leen de
+// la base de datos. Send bloquea mientras el cliente no consume, lo que
+// respeta el control de flujo de gRPC.
+func (s *server) StreamPersonas(req *pb.StreamPersonasRequest, stream pb.PersonasService_StreamPersonasServer) error {
+	log.Println("Iniciando el streaming de todas las personas.")
+	ctx := stream.Context()
+
+	enviadas := 0
+	resolver := s.newRefResolver()
+	err := s.personas.Stream(ctx, func(persona *pb.Persona) error {
+		if err := resolver.Personas(ctx, persona); err != nil {
+			return err
+		}
+		if err := stream.Send(persona); err != nil {
+			return err
+		}
+		enviadas++
+		return nil
+	}, nil)
+	if err != nil {
+		log.Printf("Error en el streaming de personas después de %d enviadas: %v", enviadas, err)
+		return streamError(ctx, err, "Error al enviar personas")
 	}
-	result := make([]string, len(array))
-	for i, v := range array {
-		result[i] = v.(string)
+
+	log.Printf("Streaming completo. Personas enviadas: %d", enviadas)
+	return nil
+}
+
+// StreamTickets - Envía todos los tickets de a uno, a medida que se leen
+func (s *server) StreamTickets(req *pb.StreamTicketsRequest, stream pb.PersonasService_StreamTicketsServer) error {
+	log.Println("Iniciando el streaming de todos los tickets.")
+	ctx := stream.Context()
+
+	enviados := 0
+	resolver := s.newRefResolver()
+	err := s.tickets.Stream(ctx, func(ticket *pb.Ticket) error {
+		if err := resolver.Tickets(ctx, ticket); err != nil {
+			return err
+		}
+		if err := stream.Send(ticket); err != nil {
+			return err
+		}
+		enviados++
+		return nil
+	}, nil)
+	if err != nil {
+		log.Printf("Error en el streaming de tickets después de %d enviados: %v", enviados, err)
+		return streamError(ctx, err, "Error al enviar tickets")
+	}
+
+	log.Printf("Streaming completo. Tickets enviados: %d", enviados)
+	return nil
+}
+
+// StreamProyectos - Envía todos los proyectos de a uno, a medida que se leen
+func (s *server) StreamProyectos(req *pb.StreamProyectosRequest, stream pb.PersonasService_StreamProyectosServer) error {
+	log.Println("Iniciando el streaming de todos los proyectos.")
+	ctx := stream.Context()
+
+	enviados := 0
+	resolver := s.newRefResolver()
+	err := s.proyectos.Stream(ctx, func(proyecto *pb.Proyecto) error {
+		if err := resolver.Proyectos(ctx, proyecto); err != nil {
+			return err
+		}
+		if err := stream.Send(proyecto); err != nil {
+			return err
+		}
+		enviados++
+		return nil
+	}, nil)
+	if err != nil {
+		log.Printf("Error en el streaming de proyectos después de %d enviados: %v", enviados, err)
+		return streamError(ctx, err, "Error al enviar proyectos")
 	}
-	return result
+
+	log.Printf("Streaming completo. Proyectos enviados: %d", enviados)
+	return nil
 }
 
 // Ejemplo de otro método con logs detallados
 func (s *server) GetPersonaByNombre(ctx context.Context, req *pb.GetPersonaByNombreRequest) (*pb.PersonaResponse, error) {
 	log.Printf("Iniciando la consulta para obtener persona por nombre: %s.", req.Nombre)
-	collection := client.Database("argentina_office").Collection("personas")
-
-	filter := bson.M{"nombre": req.Nombre}
-	var persona struct {
-		ID       string  `bson:"_id"`
-		Nombre   string  `bson:"nombre"`
-		Edad     int32   `bson:"edad"`
-		Tickets  []int32 `bson:"tickets"`
-		Proyecto string  `bson:"proyecto"`
-	}
 
-	err := collection.FindOne(ctx, filter).Decode(&persona)
+	persona, err := s.personas.GetByNombre(ctx, req.Nombre)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.Is(err, store.ErrNotFound) {
 			log.Printf("No se encontró persona con nombre: %s", req.Nombre)
 			return nil, status.Errorf(codes.NotFound, "Persona con nombre %s no encontrada", req.Nombre)
 		}
 		log.Printf("Error al buscar persona: %v", err)
 		return nil, err
 	}
+	if err := s.newRefResolver().Personas(ctx, persona); err != nil {
+		log.Printf("Error al resolver el proyecto de la persona: %v", err)
+		return nil, err
+	}
 
-	log.Printf("Persona encontrada: ID=%s, Nombre=%s, Edad=%d", persona.ID, persona.Nombre, persona.Edad)
-	return &pb.PersonaResponse{
-		Persona: &pb.Persona{
-			Id:       persona.ID,
-			Nombre:   persona.Nombre,
-			Edad:     persona.Edad,
-			Tickets:  persona.Tickets,
-			Proyecto: persona.Proyecto,
-		},
-	}, nil
+	log.Printf("Persona encontrada: ID=%s, Nombre=%s, Edad=%d", persona.Id, persona.Nombre, persona.Edad)
+	return &pb.PersonaResponse{Persona: persona}, nil
 }
 
 // Obtiene personas por rango de edades
 func (s *server) GetPersonasByAgeRange(ctx context.Context, req *pb.GetPersonasByAgeRangeRequest) (*pb.GetPersonasResponse, error) {
 	log.Printf("Buscando personas en el rango de edad: %d - %d", req.EdadMinima, req.EdadMaxima)
-	collection := client.Database("argentina_office").Collection("personas")
 
-	filter := bson.M{
-		"edad": bson.M{
-			"$gte": req.EdadMinima,
-			"$lte": req.EdadMaxima,
-		},
-	}
-
-	cursor, err := collection.Find(ctx, filter)
+	personas, err := s.personas.ListByAgeRange(ctx, req.EdadMinima, req.EdadMaxima)
 	if err != nil {
 		log.Printf("Error al obtener personas por rango de edad: %v", err)
 		return nil, err
 	}
-	defer cursor.Close(ctx)
-
-	var personas []*pb.Persona
-	for cursor.Next(ctx) {
-		var persona struct {
-			ID       primitive.ObjectID `bson:"_id"`
-			Nombre   string             `bson:"nombre"`
-			Edad     int32              `bson:"edad"`
-			Tickets  []int32            `bson:"tickets"`
-			Proyecto string             `bson:"proyecto"`
-		}
-
-		if err := cursor.Decode(&persona); err != nil {
-			log.Printf("Error al decodificar persona: %v", err)
-			return nil, err
-		}
-
-		personas = append(personas, &pb.Persona{
-			Id:       persona.ID.Hex(),
-			Nombre:   persona.Nombre,
-			Edad:     persona.Edad,
-			Tickets:  persona.Tickets,
-			Proyecto: persona.Proyecto,
-		})
+	if err := s.newRefResolver().Personas(ctx, personas...); err != nil {
+		log.Printf("Error al resolver el proyecto de las personas: %v", err)
+		return nil, err
 	}
 	return &pb.GetPersonasResponse{Personas: personas}, nil
 }
@@ -241,38 +393,15 @@ func (s *server) GetPersonasByAgeRange(ctx context.Context, req *pb.GetPersonasB
 // Obtiene personas por número de ticket
 func (s *server) GetPersonasPorNumeroDeTicket(ctx context.Context, req *pb.GetPersonasPorNumeroDeTicketRequest) (*pb.GetPersonasResponse, error) {
 	log.Printf("Buscando personas con ticket número: %d", req.TicketNumero)
-	collection := client.Database("argentina_office").Collection("personas")
 
-	filter := bson.M{"tickets": req.TicketNumero}
-	cursor, err := collection.Find(ctx, filter)
+	personas, err := s.personas.ListByTicket(ctx, req.TicketNumero)
 	if err != nil {
 		log.Printf("Error al obtener personas por número de ticket: %v", err)
 		return nil, err
 	}
-	defer cursor.Close(ctx)
-
-	var personas []*pb.Persona
-	for cursor.Next(ctx) {
-		var persona struct {
-			ID       primitive.ObjectID `bson:"_id"`
-			Nombre   string             `bson:"nombre"`
-			Edad     int32              `bson:"edad"`
-			Tickets  []int32            `bson:"tickets"`
-			Proyecto string             `bson:"proyecto"`
-		}
-
-		if err := cursor.Decode(&persona); err != nil {
-			log.Printf("Error al decodificar persona: %v", err)
-			return nil, err
-		}
-
-		personas = append(personas, &pb.Persona{
-			Id:       persona.ID.Hex(),
-			Nombre:   persona.Nombre,
-			Edad:     persona.Edad,
-			Tickets:  persona.Tickets,
-			Proyecto: persona.Proyecto,
-		})
+	if err := s.newRefResolver().Personas(ctx, personas...); err != nil {
+		log.Printf("Error al resolver el proyecto de las personas: %v", err)
+		return nil, err
 	}
 	return &pb.GetPersonasResponse{Personas: personas}, nil
 }
@@ -280,116 +409,174 @@ func (s *server) GetPersonasPorNumeroDeTicket(ctx context.Context, req *pb.GetPe
 // Obtiene un ticket por número de ticket
 func (s *server) GetTicketPorNumero(ctx context.Context, req *pb.GetTicketPorNumeroRequest) (*pb.TicketResponse, error) {
 	log.Printf("Buscando ticket con número: %d", req.TicketNumero)
-	collection := client.Database("argentina_office").Collection("tickets")
 
-	var ticket struct {
-		ID           primitive.ObjectID `bson:"_id"`
-		TicketNumero int32              `bson:"ticket_numero"`
-		Owner        string             `bson:"owner"`
-	}
-	err := collection.FindOne(ctx, bson.M{"ticket_numero": req.TicketNumero}).Decode(&ticket)
+	ticket, err := s.tickets.GetByNumero(ctx, req.TicketNumero)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "Ticket no encontrado")
 		}
 		log.Printf("Error al buscar el ticket: %v", err)
 		return nil, err
 	}
+	if err := s.newRefResolver().Tickets(ctx, ticket); err != nil {
+		log.Printf("Error al resolver el dueño del ticket: %v", err)
+		return nil, err
+	}
 
-	return &pb.TicketResponse{
-		Ticket: &pb.Ticket{
-			Id:           ticket.ID.Hex(),
-			TicketNumero: ticket.TicketNumero,
-			Owner:        ticket.Owner,
-		},
-	}, nil
+	return &pb.TicketResponse{Ticket: ticket}, nil
 }
 
-// Obtiene un ticket por nombre del dueño
+// Obtiene un ticket por ID o nombre del dueño
 func (s *server) GetTicketPorDueno(ctx context.Context, req *pb.GetTicketPorDuenoRequest) (*pb.TicketResponse, error) {
-	log.Printf("Buscando ticket para el dueño: %s", req.Dueno)
-	collection := client.Database("argentina_office").Collection("tickets")
+	log.Printf("Buscando ticket para el dueño: ID=%s, Nombre=%s", req.OwnerId, req.Dueno)
 
-	var ticket struct {
-		ID           primitive.ObjectID `bson:"_id"`
-		TicketNumero int32              `bson:"ticket_numero"`
-		Owner        string             `bson:"owner"`
+	ticket, err := s.ticketByOwner(ctx, store.Ref{ID: req.OwnerId, Nombre: req.Dueno})
+	if err == nil {
+		err = s.newRefResolver().Tickets(ctx, ticket)
 	}
-	err := collection.FindOne(ctx, bson.M{"owner": req.Dueno}).Decode(&ticket)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "Ticket no encontrado")
 		}
 		log.Printf("Error al buscar el ticket: %v", err)
 		return nil, err
 	}
 
-	return &pb.TicketResponse{
-		Ticket: &pb.Ticket{
-			Id:           ticket.ID.Hex(),
-			TicketNumero: ticket.TicketNumero,
-			Owner:        ticket.Owner,
-		},
-	}, nil
+	return &pb.TicketResponse{Ticket: ticket}, nil
 }
 
-func (s *server) GetProyectoPorColaborador(ctx context.Context, req *pb.GetProyectoPorColaboradorRequest) (*pb.ProyectoResponse, error) {
-	log.Printf("Buscando proyecto con el colaborador: %s", req.Colaborador)
-	collection := client.Database("argentina_office").Collection("proyectos")
+// ticketByOwner busca un ticket por el ID del dueño. Si solo se conoce el
+// nombre se busca la persona, y si no existe o ninguno de sus tickets tiene
+// owner_id se busca por nombre, como en los documentos anteriores a las
+// referencias por ID.
+func (s *server) ticketByOwner(ctx context.Context, owner store.Ref) (*pb.Ticket, error) {
+	if owner.ID != "" {
+		return s.tickets.GetByOwnerID(ctx, owner.ID)
+	}
+	persona, err := s.personas.GetByNombre(ctx, owner.Nombre)
+	if err == nil {
+		ticket, err := s.tickets.GetByOwnerID(ctx, persona.Id)
+		if !errors.Is(err, store.ErrNotFound) {
+			return ticket, err
+		}
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	}
+	return s.tickets.GetByOwner(ctx, owner.Nombre)
+}
 
-	// Crear un filtro para buscar proyectos que contengan al colaborador en la lista
-	filter := bson.M{"colaboradores": req.Colaborador}
+// ListTicketsByOwner - Maneja la solicitud para obtener los tickets de un
+// dueño, por ID o por nombre, ordenados por número
+func (s *server) ListTicketsByOwner(ctx context.Context, req *pb.ListTicketsByOwnerRequest) (*pb.GetTicketsResponse, error) {
+	log.Printf("Buscando tickets del dueño: ID=%s, Nombre=%s, Números=%d-%d", req.OwnerId, req.Dueno, req.TicketNumeroMin, req.TicketNumeroMax)
 
-	// Definir una estructura temporal para decodificar el proyecto
-	var proyecto struct {
-		ID              primitive.ObjectID `bson:"_id"`
-		Nombre          string             `bson:"nombre"`
-		Colaboradores   []string           `bson:"colaboradores"`
-		NivelDificultad string             `bson:"nivel_dificultad"`
+	if req.TicketNumeroMax != 0 && req.TicketNumeroMin > req.TicketNumeroMax {
+		return nil, status.Error(codes.InvalidArgument, "ticket_numero_min no puede ser mayor que ticket_numero_max")
+	}
+	page, err := s.page(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	// Si la persona no existe se buscan igual los tickets que la nombran
+	owner, _, err := resolveRef(ctx, store.Ref{ID: req.OwnerId, Nombre: req.Dueno}, "owner", s.personaByID, s.personaByNombre)
+	if err != nil {
+		log.Printf("Error al buscar el dueño: %v", err)
+		return nil, txError(err, "", "Error al obtener tickets")
+	}
+	q, err := parseQuery(ownerTicketsFilter(owner, req.TicketNumeroMin, req.TicketNumeroMax), "ticket_numero", store.TicketFields)
+	if err != nil {
+		return nil, err
 	}
 
-	// Buscar un único documento que coincida con el filtro
-	err := collection.FindOne(ctx, filter).Decode(&proyecto)
+	resultado, nextPageToken, err := s.tickets.List(ctx, q, page)
+	if err == nil {
+		err = s.newRefResolver().Tickets(ctx, resultado...)
+	}
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			log.Printf("No se encontró ningún proyecto para el colaborador: %s", req.Colaborador)
-			return nil, status.Errorf(codes.NotFound, "No se encontró ningún proyecto para el colaborador %s", req.Colaborador)
+		log.Printf("Error al obtener tickets: %v", err)
+		return nil, storeError(err, "", "Error al obtener tickets")
+	}
+
+	log.Printf("Tickets encontrados: %d", len(resultado))
+	return &pb.GetTicketsResponse{Tickets: resultado, NextPageToken: nextPageToken}, nil
+}
+
+// ownerTicketsFilter arma el filtro de los tickets del dueño con la misma
+// regla que las referencias: por owner_id, y por owner en los tickets que no
+// tienen ID
+func ownerTicketsFilter(owner store.Ref, first, last int32) string {
+	var byOwner []string
+	if owner.ID != "" {
+		byOwner = append(byOwner, "owner_id = "+query.Quote(owner.ID))
+	}
+	if owner.Nombre != "" {
+		byOwner = append(byOwner, `(owner_id = "" AND owner = `+query.Quote(owner.Nombre)+")")
+	}
+	conditions := []string{"(" + strings.Join(byOwner, " OR ") + ")"}
+	if first > 0 {
+		conditions = append(conditions, "ticket_numero >= "+strconv.Itoa(int(first)))
+	}
+	if last > 0 {
+		conditions = append(conditions, "ticket_numero <= "+strconv.Itoa(int(last)))
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func (s *server) GetProyectoPorColaborador(ctx context.Context, req *pb.GetProyectoPorColaboradorRequest) (*pb.ProyectoResponse, error) {
+	log.Printf("Buscando proyecto con el colaborador: ID=%s, Nombre=%s", req.ColaboradorId, req.Colaborador)
+
+	colaborador := store.Ref{ID: req.ColaboradorId, Nombre: req.Colaborador}
+	proyecto, err := s.proyectoByColaborador(ctx, colaborador)
+	if err == nil {
+		err = s.newRefResolver().Proyectos(ctx, proyecto)
+	}
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Printf("No se encontró ningún proyecto para el colaborador: %s", refKey(colaborador))
+			return nil, status.Errorf(codes.NotFound, "No se encontró ningún proyecto para el colaborador %s", refKey(colaborador))
 		}
 		log.Printf("Error al buscar el proyecto: %v", err)
 		return nil, err
 	}
 
 	// Retornar el proyecto encontrado
-	return &pb.ProyectoResponse{
-		Proyecto: &pb.Proyecto{
-			Id:              proyecto.ID.Hex(),
-			Nombre:          proyecto.Nombre,
-			Colaboradores:   proyecto.Colaboradores,
-			NivelDificultad: proyecto.NivelDificultad,
-		},
-	}, nil
+	return &pb.ProyectoResponse{Proyecto: proyecto}, nil
+}
+
+// proyectoByColaborador busca un proyecto por el ID de un colaborador, con la
+// misma búsqueda por nombre que ticketByOwner
+func (s *server) proyectoByColaborador(ctx context.Context, colaborador store.Ref) (*pb.Proyecto, error) {
+	if colaborador.ID != "" {
+		return s.proyectos.GetByColaboradorID(ctx, colaborador.ID)
+	}
+	persona, err := s.personas.GetByNombre(ctx, colaborador.Nombre)
+	if err == nil {
+		proyecto, err := s.proyectos.GetByColaboradorID(ctx, persona.Id)
+		if !errors.Is(err, store.ErrNotFound) {
+			return proyecto, err
+		}
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	}
+	return s.proyectos.GetByColaborador(ctx, colaborador.Nombre)
 }
 
 func (s *server) GetColaboradoresPorProyecto(ctx context.Context, req *pb.GetColaboradoresPorProyectoRequest) (*pb.GetColaboradoresPorProyectoResponse, error) {
 	log.Printf("Buscando colaboradores para el proyecto: %s", req.NombreProyecto)
-	collection := client.Database("argentina_office").Collection("proyectos")
-
-	// Filtro para encontrar el proyecto por nombre
-	filter := bson.M{"nombre": req.NombreProyecto}
-	var proyecto struct {
-		Colaboradores []string `bson:"colaboradores"`
-	}
 
-	// Buscar el proyecto
-	err := collection.FindOne(ctx, filter).Decode(&proyecto)
+	proyecto, err := s.proyectos.GetByNombre(ctx, req.NombreProyecto)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.Is(err, store.ErrNotFound) {
 			log.Printf("No se encontró el proyecto: %s", req.NombreProyecto)
 			return nil, status.Errorf(codes.NotFound, "No se encontró el proyecto %s", req.NombreProyecto)
 		}
 		log.Printf("Error al buscar el proyecto: %v", err)
 		return nil, err
 	}
+	if err := s.newRefResolver().Proyectos(ctx, proyecto); err != nil {
+		log.Printf("Error al resolver los colaboradores del proyecto: %v", err)
+		return nil, err
+	}
 
 	// Retornar la lista de colaboradores
 	log.Printf("Colaboradores encontrados para el proyecto %s: %v", req.NombreProyecto, proyecto.Colaboradores)
@@ -400,47 +587,69 @@ func (s *server) GetColaboradoresPorProyecto(ctx context.Context, req *pb.GetCol
 
 func (s *server) CreatePersona(ctx context.Context, req *pb.CreatePersonaRequest) (*pb.CreatePersonaResponse, error) {
 	log.Printf("Creando persona: Nombre=%s, Edad=%d", req.Nombre, req.Edad)
-	collection := client.Database("argentina_office").Collection("personas")
 
-	persona := bson.M{
-		"nombre":   req.Nombre,
-		"edad":     req.Edad,
-		"tickets":  req.Tickets,
-		"proyecto": req.Proyecto,
+	persona := &pb.Persona{
+		Nombre:     req.Nombre,
+		Edad:       req.Edad,
+		Proyecto:   req.Proyecto,
+		ProyectoId: req.ProyectoId,
+	}
+	if err := s.resolvePersonaReferences(ctx, persona, store.PersonaUpdateFields); err != nil {
+		return nil, err
 	}
 
-	result, err := collection.InsertOne(ctx, persona)
+	id, replayed, err := s.idempotent(ctx, "CreatePersona", req, req.IdempotencyKey, func() (string, error) {
+		id, err := s.personas.Create(ctx, persona)
+		if err != nil {
+			log.Printf("Error al crear persona: %v", err)
+			return "", storeError(err, "", "No se pudo crear la persona")
+		}
+		return id, nil
+	})
 	if err != nil {
-		log.Printf("Error al crear persona: %v", err)
-		return nil, status.Errorf(codes.Internal, "No se pudo crear la persona")
+		return nil, err
+	}
+
+	version := store.InitialVersion
+	if replayed {
+		// La persona pudo modificarse desde la creación original
+		created, err := s.personas.Get(ctx, id)
+		if err != nil {
+			log.Printf("Error al leer la persona creada: %v", err)
+			return nil, storeError(err, "La persona creada con esta idempotency_key ya no existe", "No se pudo crear la persona")
+		}
+		version = created.Version
 	}
 
-	id := result.InsertedID.(primitive.ObjectID).Hex()
 	log.Printf("Persona creada con ID: %s", id)
-	return &pb.CreatePersonaResponse{Id: id}, nil
+	return &pb.CreatePersonaResponse{Id: id, Version: version}, nil
 }
 
 func (s *server) UpdatePersona(ctx context.Context, req *pb.UpdatePersonaRequest) (*pb.UpdatePersonaResponse, error) {
-	log.Printf("Actualizando persona con ID: %s", req.Id)
-	collection := client.Database("argentina_office").Collection("personas")
+	log.Printf("Actualizando persona con ID: %s, Versión: %d, Campos: %v", req.Id, req.Version, req.UpdateMask.GetPaths())
 
-	objID, err := primitive.ObjectIDFromHex(req.Id)
+	fields, err := updateFields(req.UpdateMask, store.PersonaUpdateFields)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "ID inválido")
+		log.Printf("update_mask inválido: %v", err)
+		return nil, err
 	}
+	fields = referenceFields(fields)
 
-	filter := bson.M{"_id": objID}
-	update := bson.M{"$set": bson.M{
-		"nombre":   req.Nombre,
-		"edad":     req.Edad,
-		"tickets":  req.Tickets,
-		"proyecto": req.Proyecto,
-	}}
+	persona := &pb.Persona{
+		Id:         req.Id,
+		Nombre:     req.Nombre,
+		Edad:       req.Edad,
+		Proyecto:   req.Proyecto,
+		ProyectoId: req.ProyectoId,
+		Version:    req.Version,
+	}
+	if err := s.resolvePersonaReferences(ctx, persona, fields); err != nil {
+		return nil, err
+	}
 
-	result, err := collection.UpdateOne(ctx, filter, update)
-	if err != nil || result.MatchedCount == 0 {
+	if err := s.personas.Update(ctx, persona, fields); err != nil {
 		log.Printf("Error al actualizar persona: %v", err)
-		return nil, status.Errorf(codes.NotFound, "Persona no encontrada")
+		return nil, storeError(err, "Persona no encontrada", "Error al actualizar la persona")
 	}
 
 	log.Printf("Persona actualizada correctamente")
@@ -449,76 +658,185 @@ func (s *server) UpdatePersona(ctx context.Context, req *pb.UpdatePersonaRequest
 
 func (s *server) DeletePersona(ctx context.Context, req *pb.DeletePersonaRequest) (*pb.DeletePersonaResponse, error) {
 	log.Printf("Eliminando persona con ID: %s", req.Id)
-	collection := client.Database("argentina_office").Collection("personas")
 
-	objID, err := primitive.ObjectIDFromHex(req.Id)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "ID inválido")
-	}
+	// La verificación de referencias, el borrado y la actualización de las
+	// referencias se hacen en una transacción si la base la admite
+	var released store.References
+	err := s.unitOfWork.RunIfSupported(ctx, func(ctx context.Context) error {
+		persona, err := s.personas.Get(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		var reassignTo store.Ref
+		if req.ReassignTicketsToId != "" || req.ReassignTicketsTo != "" {
+			reassignTo, err = s.resolveReassign(ctx, persona, store.Ref{ID: req.ReassignTicketsToId, Nombre: req.ReassignTicketsTo})
+			if err != nil {
+				return err
+			}
+		}
 
-	filter := bson.M{"_id": objID}
-	result, err := collection.DeleteOne(ctx, filter)
-	if err != nil || result.DeletedCount == 0 {
+		refs, err := s.references.PersonaReferences(ctx, personaRef(persona))
+		if err != nil {
+			return err
+		}
+		// Los tickets que se reasignan no impiden el borrado
+		if reassignTo != (store.Ref{}) {
+			refs.Tickets = 0
+		}
+		// Con la política none solo se actualizan las referencias si se pidió
+		// reasignar los tickets
+		if s.keepReferences() && reassignTo == (store.Ref{}) {
+			return s.personas.Delete(ctx, req.Id, req.Version)
+		}
+		if err := s.blockReferences("personas/"+persona.Id, refs); err != nil {
+			return err
+		}
+
+		// Los tickets se leen antes de liberarlos para registrar el cambio de dueño
+		owned, err := s.tickets.ListByOwner(ctx, personaRef(persona))
+		if err != nil {
+			return err
+		}
+		if err := s.personas.Delete(ctx, req.Id, req.Version); err != nil {
+			return err
+		}
+		released, err = s.references.ReleasePersona(ctx, personaRef(persona), reassignTo)
+		if err != nil {
+			return err
+		}
+		return s.ownersChanged(ctx, owned)
+	})
+	if err != nil {
 		log.Printf("Error al eliminar persona: %v", err)
-		return nil, status.Errorf(codes.NotFound, "Persona no encontrada")
+		return nil, txError(err, "Persona no encontrada", "Error al eliminar la persona")
 	}
 
-	log.Printf("Persona eliminada correctamente")
+	log.Printf("Persona eliminada correctamente. Referencias actualizadas: tickets=%d, proyectos=%d", released.Tickets, released.Proyectos)
 	return &pb.DeletePersonaResponse{Success: true}, nil
 }
 
 // Método para crear un nuevo ticket
 func (s *server) CreateTicket(ctx context.Context, req *pb.CreateTicketRequest) (*pb.CreateTicketResponse, error) {
-	log.Printf("Creando un nuevo ticket: Número=%d, Owner=%s", req.TicketNumero, req.Owner)
+	log.Printf("Creando un nuevo ticket: Número=%d, Secuencia=%s, OwnerID=%s, Owner=%s", req.TicketNumero, req.Sequence, req.OwnerId, req.Owner)
 
-	collection := client.Database("argentina_office").Collection("tickets")
+	// Un número explícito no usa ninguna secuencia
+	if req.TicketNumero != 0 && req.Sequence != "" {
+		return nil, status.Error(codes.InvalidArgument, "sequence solo se puede usar si ticket_numero está vacío")
+	}
+	sequence, first, err := s.ticketSequence(req.Sequence)
+	if err != nil {
+		return nil, err
+	}
 
-	newTicket := bson.M{
-		"ticket_numero": req.TicketNumero,
-		"owner":         req.Owner,
+	ticket := &pb.Ticket{
+		TicketNumero: req.TicketNumero,
+		Owner:        req.Owner,
+		OwnerId:      req.OwnerId,
+		Status:       pb.TicketStatus_TICKET_STATUS_OPEN,
+		Priority:     req.Priority,
+		Title:        req.Title,
+		Description:  req.Description,
+	}
+	if err := s.resolveTicketReferences(ctx, ticket, store.TicketUpdateFields); err != nil {
+		return nil, err
 	}
 
-	res, err := collection.InsertOne(ctx, newTicket)
+	id, replayed, err := s.idempotent(ctx, "CreateTicket", req, req.IdempotencyKey, func() (string, error) {
+		if req.TicketNumero == 0 {
+			id, err := s.createNumbered(ctx, ticket, sequence, first)
+			if err != nil {
+				log.Printf("Error al crear el ticket: %v", err)
+				return "", txError(err, "", "Error al crear el ticket")
+			}
+			return id, nil
+		}
+		id, err := s.insertTicket(ctx, ticket)
+		if errors.Is(err, store.ErrAlreadyExists) {
+			log.Printf("El número %d ya está en uso", req.TicketNumero)
+			return "", status.Errorf(codes.AlreadyExists, "Ya existe un ticket con el número %d", req.TicketNumero)
+		}
+		if err != nil {
+			log.Printf("Error al crear el ticket: %v", err)
+			return "", storeError(err, "", "Error al crear el ticket")
+		}
+		return id, nil
+	})
 	if err != nil {
-		log.Printf("Error al crear el ticket: %v", err)
-		return nil, status.Error(codes.Internal, "Error al crear el ticket")
+		return nil, err
+	}
+	// Una repetición con la misma idempotency_key no vuelve a numerar el
+	// ticket: el número y la versión se leen del ticket creado originalmente,
+	// que pudo modificarse desde entonces
+	version := store.InitialVersion
+	if ticket.TicketNumero == 0 || replayed {
+		created, err := s.tickets.Get(ctx, id)
+		if err != nil {
+			log.Printf("Error al leer el ticket creado: %v", err)
+			return nil, storeError(err, "El ticket creado con esta idempotency_key ya no existe", "Error al crear el ticket")
+		}
+		ticket.TicketNumero = created.TicketNumero
+		if replayed {
+			version = created.Version
+		}
 	}
 
-	id := res.InsertedID.(primitive.ObjectID).Hex()
-	log.Printf("Ticket creado con ID: %s", id)
-
-	return &pb.CreateTicketResponse{Id: id}, nil
+	log.Printf("Ticket creado con ID: %s, Número: %d", id, ticket.TicketNumero)
+	return &pb.CreateTicketResponse{Id: id, Version: version, TicketNumero: ticket.TicketNumero}, nil
 }
 
 // Método para actualizar un ticket
 func (s *server) UpdateTicket(ctx context.Context, req *pb.UpdateTicketRequest) (*emptypb.Empty, error) {
-	log.Printf("Actualizando ticket con ID=%s", req.Id)
-
-	collection := client.Database("argentina_office").Collection("tickets")
+	log.Printf("Actualizando ticket con ID=%s, Versión=%d, Campos=%v", req.Id, req.Version, req.UpdateMask.GetPaths())
 
-	objID, err := primitive.ObjectIDFromHex(req.Id)
+	fields, err := updateFields(req.UpdateMask, store.TicketUpdateFields)
 	if err != nil {
-		log.Printf("ID de ticket no válido: %v", err)
-		return nil, status.Error(codes.InvalidArgument, "ID de ticket no válido")
+		log.Printf("update_mask inválido: %v", err)
+		return nil, err
 	}
+	fields = referenceFields(fields)
 
-	filter := bson.M{"_id": objID}
-	update := bson.M{
-		"$set": bson.M{
-			"ticket_numero": req.TicketNumero,
-			"owner":         req.Owner,
-		},
+	ticket := &pb.Ticket{
+		Id:           req.Id,
+		TicketNumero: req.TicketNumero,
+		Owner:        req.Owner,
+		OwnerId:      req.OwnerId,
+		Version:      req.Version,
+		Priority:     req.Priority,
+		Title:        req.Title,
+		Description:  req.Description,
+	}
+	if err := s.resolveTicketReferences(ctx, ticket, fields); err != nil {
+		return nil, err
+	}
+	// Asignar un dueño, aunque sea vacío, deja de marcar al ticket como huérfano
+	if slices.Contains(fields, "owner") {
+		fields = append(fields, "huerfano")
 	}
 
-	res, err := collection.UpdateOne(ctx, filter, update)
+	// El cambio y su evento en el historial se guardan en una transacción si
+	// la base la admite
+	err = s.unitOfWork.RunIfSupported(ctx, func(ctx context.Context) error {
+		before, err := s.tickets.Get(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		if err := s.tickets.Update(ctx, ticket, fields); err != nil {
+			return err
+		}
+		after, err := s.tickets.Get(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		if after.OwnerId != before.OwnerId || after.Owner != before.Owner || after.TicketNumero != before.TicketNumero {
+			if _, err := s.syncOwner(ctx, before, after); err != nil {
+				return err
+			}
+		}
+		return s.recordEvent(ctx, pb.TicketEventType_TICKET_EVENT_TYPE_UPDATED, before, after, fields)
+	})
 	if err != nil {
 		log.Printf("Error al actualizar el ticket: %v", err)
-		return nil, status.Error(codes.Internal, "Error al actualizar el ticket")
-	}
-
-	if res.MatchedCount == 0 {
-		log.Printf("Ticket no encontrado: %s", req.Id)
-		return nil, status.Error(codes.NotFound, "Ticket no encontrado")
+		return nil, txError(err, "Ticket no encontrado", "Error al actualizar el ticket")
 	}
 
 	log.Printf("Ticket actualizado con éxito: ID=%s", req.Id)
@@ -529,76 +847,108 @@ func (s *server) UpdateTicket(ctx context.Context, req *pb.UpdateTicketRequest)
 func (s *server) DeleteTicket(ctx context.Context, req *pb.DeleteTicketRequest) (*emptypb.Empty, error) {
 	log.Printf("Eliminando ticket con ID=%s", req.Id)
 
-	collection := client.Database("argentina_office").Collection("tickets")
-
-	objID, err := primitive.ObjectIDFromHex(req.Id)
-	if err != nil {
-		log.Printf("ID de ticket no válido: %v", err)
-		return nil, status.Error(codes.InvalidArgument, "ID de ticket no válido")
-	}
+	var released store.References
+	err := s.unitOfWork.RunIfSupported(ctx, func(ctx context.Context) error {
+		ticket, err := s.tickets.Get(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		refs, err := s.references.TicketReferences(ctx, ticket.TicketNumero)
+		if err != nil {
+			return err
+		}
+		if err := s.blockReferences("tickets/"+strconv.Itoa(int(ticket.TicketNumero)), refs); err != nil {
+			return err
+		}
 
-	filter := bson.M{"_id": objID}
-	res, err := collection.DeleteOne(ctx, filter)
+		if err := s.tickets.Delete(ctx, req.Id, req.Version); err != nil {
+			return err
+		}
+		// personas.tickets sigue al dueño del ticket, así que el número se quita
+		// con cualquier política, incluso none
+		released, err = s.references.ReleaseTicket(ctx, ticket.TicketNumero)
+		return err
+	})
 	if err != nil {
 		log.Printf("Error al eliminar el ticket: %v", err)
-		return nil, status.Error(codes.Internal, "Error al eliminar el ticket")
-	}
-
-	if res.DeletedCount == 0 {
-		log.Printf("Ticket no encontrado: %s", req.Id)
-		return nil, status.Error(codes.NotFound, "Ticket no encontrado")
+		return nil, txError(err, "Ticket no encontrado", "Error al eliminar el ticket")
 	}
 
-	log.Printf("Ticket eliminado con éxito: ID=%s", req.Id)
+	log.Printf("Ticket eliminado con éxito: ID=%s. Referencias actualizadas: personas=%d", req.Id, released.Personas)
 	return &emptypb.Empty{}, nil
 }
 
 // Método para crear un proyecto
 func (s *server) CreateProyecto(ctx context.Context, req *pb.CreateProyectoRequest) (*pb.CreateProyectoResponse, error) {
-	log.Printf("Creando proyecto: Nombre=%s, Dificultad=%s", req.Nombre, req.NivelDificultad)
+	log.Printf("Creando proyecto: Nombre=%s, Dificultad=%s, Nivel=%s", req.Nombre, req.Dificultad, req.NivelDificultad)
 
-	collection := client.Database("argentina_office").Collection("proyectos")
-	proyecto := bson.M{
-		"nombre":           req.Nombre,
-		"colaboradores":    req.Colaboradores,
-		"nivel_dificultad": req.NivelDificultad,
+	proyecto := &pb.Proyecto{
+		Nombre:         req.Nombre,
+		Colaboradores:  req.Colaboradores,
+		ColaboradorIds: req.ColaboradorIds,
+	}
+	if err := setDificultad(proyecto, req.Dificultad, req.NivelDificultad); err != nil {
+		return nil, err
+	}
+	if err := s.resolveProyectoReferences(ctx, proyecto, store.ProyectoUpdateFields); err != nil {
+		return nil, err
 	}
 
-	res, err := collection.InsertOne(ctx, proyecto)
+	id, replayed, err := s.idempotent(ctx, "CreateProyecto", req, req.IdempotencyKey, func() (string, error) {
+		id, err := s.proyectos.Create(ctx, proyecto)
+		if err != nil {
+			log.Printf("Error al crear el proyecto: %v", err)
+			return "", storeError(err, "", "Error al crear el proyecto")
+		}
+		return id, nil
+	})
 	if err != nil {
-		log.Printf("Error al crear el proyecto: %v", err)
 		return nil, err
 	}
 
-	id := res.InsertedID.(primitive.ObjectID).Hex()
-	log.Printf("Proyecto creado con ID: %s", id)
+	version := store.InitialVersion
+	if replayed {
+		// El proyecto pudo modificarse desde la creación original
+		created, err := s.proyectos.Get(ctx, id)
+		if err != nil {
+			log.Printf("Error al leer el proyecto creado: %v", err)
+			return nil, storeError(err, "El proyecto creado con esta idempotency_key ya no existe", "Error al crear el proyecto")
+		}
+		version = created.Version
+	}
 
-	return &pb.CreateProyectoResponse{Id: id}, nil
+	log.Printf("Proyecto creado con ID: %s", id)
+	return &pb.CreateProyectoResponse{Id: id, Version: version}, nil
 }
 
 // Método para actualizar un proyecto
 func (s *server) UpdateProyecto(ctx context.Context, req *pb.UpdateProyectoRequest) (*emptypb.Empty, error) {
-	log.Printf("Actualizando proyecto con ID: %s", req.Id)
+	log.Printf("Actualizando proyecto con ID: %s, Versión: %d, Campos: %v", req.Id, req.Version, req.UpdateMask.GetPaths())
 
-	collection := client.Database("argentina_office").Collection("proyectos")
-	objID, err := primitive.ObjectIDFromHex(req.Id)
+	fields, err := updateFields(req.UpdateMask, store.ProyectoUpdateFields)
 	if err != nil {
-		log.Printf("ID de proyecto inválido: %v", err)
-		return nil, status.Error(codes.InvalidArgument, "ID de proyecto inválido")
+		log.Printf("update_mask inválido: %v", err)
+		return nil, err
 	}
+	fields = dificultadFields(referenceFields(fields))
 
-	update := bson.M{
-		"$set": bson.M{
-			"nombre":           req.Nombre,
-			"colaboradores":    req.Colaboradores,
-			"nivel_dificultad": req.NivelDificultad,
-		},
+	proyecto := &pb.Proyecto{
+		Id:             req.Id,
+		Nombre:         req.Nombre,
+		Colaboradores:  req.Colaboradores,
+		ColaboradorIds: req.ColaboradorIds,
+		Version:        req.Version,
+	}
+	if err := setDificultad(proyecto, req.Dificultad, req.NivelDificultad); err != nil {
+		return nil, err
+	}
+	if err := s.resolveProyectoReferences(ctx, proyecto, fields); err != nil {
+		return nil, err
 	}
 
-	_, err = collection.UpdateOne(ctx, bson.M{"_id": objID}, update)
-	if err != nil {
+	if err := s.proyectos.Update(ctx, proyecto, fields); err != nil {
 		log.Printf("Error al actualizar el proyecto: %v", err)
-		return nil, err
+		return nil, storeError(err, "Proyecto no encontrado", "Error al actualizar el proyecto")
 	}
 
 	log.Printf("Proyecto actualizado con ID: %s", req.Id)
@@ -609,43 +959,34 @@ func (s *server) UpdateProyecto(ctx context.Context, req *pb.UpdateProyectoReque
 func (s *server) DeleteProyecto(ctx context.Context, req *pb.DeleteProyectoRequest) (*emptypb.Empty, error) {
 	log.Printf("Eliminando proyecto con ID: %s", req.Id)
 
-	collection := client.Database("argentina_office").Collection("proyectos")
-	objID, err := primitive.ObjectIDFromHex(req.Id)
-	if err != nil {
-		log.Printf("ID de proyecto inválido: %v", err)
-		return nil, status.Error(codes.InvalidArgument, "ID de proyecto inválido")
-	}
+	var released store.References
+	err := s.unitOfWork.RunIfSupported(ctx, func(ctx context.Context) error {
+		if s.keepReferences() {
+			return s.proyectos.Delete(ctx, req.Id, req.Version)
+		}
+		proyecto, err := s.proyectos.Get(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		refs, err := s.references.ProyectoReferences(ctx, proyectoRef(proyecto))
+		if err != nil {
+			return err
+		}
+		if err := s.blockReferences("proyectos/"+proyecto.Id, refs); err != nil {
+			return err
+		}
 
-	_, err = collection.DeleteOne(ctx, bson.M{"_id": objID})
+		if err := s.proyectos.Delete(ctx, req.Id, req.Version); err != nil {
+			return err
+		}
+		released, err = s.references.ReleaseProyecto(ctx, proyectoRef(proyecto))
+		return err
+	})
 	if err != nil {
 		log.Printf("Error al eliminar el proyecto: %v", err)
-		return nil, err
+		return nil, txError(err, "Proyecto no encontrado", "Error al eliminar el proyecto")
 	}
 
-	log.Printf("Proyecto eliminado con ID: %s", req.Id)
+	log.Printf("Proyecto eliminado con ID: %s. Referencias actualizadas: personas=%d", req.Id, released.Personas)
 	return &emptypb.Empty{}, nil
 }
-
-func main() {
-	var err error
-	client, err = db.ConnectDB()
-	if err != nil {
-		log.Fatalf("Error al conectar a la base de datos: %v", err)
-	}
-
-	lis, err := net.Listen("tcp", ":50051")
-	if err != nil {
-		log.Fatalf("Error al iniciar el servidor: %v", err)
-	}
-
-	s := grpc.NewServer()
-	pb.RegisterPersonasServiceServer(s, &server{})
-	pb.RegisterCreateServiceServer(s, &server{})
-	reflection.Register(s)
-
-	log.Println("Servidor en ejecución en el puerto 50051")
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("Error al iniciar el servicio: %v", err)
-	}
-
-}
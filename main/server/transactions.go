@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Dentro de una transacción los errores del store se devuelven sin traducir:
+// MongoDB marca los conflictos transitorios en el error original, y si se
+// convierte a un error gRPC la transacción no se reintenta.
+
+// txError traduce el error de una transacción: los errores gRPC que devolvió
+// la función se devuelven tal cual y los del store se traducen con storeError
+func txError(err error, notFound string, internal string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return storeError(err, notFound, internal)
+}
+
+// notFound devuelve NOT_FOUND con message si err es ErrNotFound y cualquier
+// otro error sin traducir, para distinguir qué documento falta dentro de una
+// transacción
+func notFound(err error, message string) error {
+	if errors.Is(err, store.ErrNotFound) {
+		return status.Error(codes.NotFound, message)
+	}
+	return err
+}
+
+// ownerID devuelve el ID del dueño del ticket. Los tickets anteriores a las
+// referencias por ID se buscan por nombre; si el dueño no existe devuelve vacío.
+func (s *server) ownerID(ctx context.Context, ticket *pb.Ticket) (string, error) {
+	if ticket.OwnerId != "" || ticket.Owner == "" {
+		return ticket.OwnerId, nil
+	}
+	persona, err := s.personas.GetByNombre(ctx, ticket.Owner)
+	if errors.Is(err, store.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return persona.Id, nil
+}
+
+// syncOwner actualiza personas.tickets después de que cambió el dueño o el
+// número del ticket: quita los números de before y after de todas las personas
+// y agrega after al dueño actual, si tiene. Devuelve cuántas personas tenían
+// el ticket.
+func (s *server) syncOwner(ctx context.Context, before, after *pb.Ticket) (store.References, error) {
+	released, err := s.references.ReleaseTicket(ctx, before.TicketNumero)
+	if err != nil {
+		return released, err
+	}
+	if after.TicketNumero != before.TicketNumero {
+		// Una lista desactualizada puede tener ya el número nuevo
+		stale, err := s.references.ReleaseTicket(ctx, after.TicketNumero)
+		if err != nil {
+			return released, err
+		}
+		released.Personas += stale.Personas
+	}
+
+	owner, err := s.ownerID(ctx, after)
+	if err != nil || owner == "" {
+		return released, err
+	}
+	_, err = s.references.AddTicket(ctx, owner, after.TicketNumero)
+	return released, err
+}
+
+// AssignTicket - Maneja la solicitud para asignar un ticket a una persona. En
+// una transacción cambia el owner del ticket, lo quita de los tickets de
+// cualquier otra persona y lo agrega a los de la nueva.
+func (s *server) AssignTicket(ctx context.Context, req *pb.AssignTicketRequest) (*pb.AssignTicketResponse, error) {
+	log.Printf("Asignando ticket %d a ID=%s, Nombre=%s", req.TicketNumero, req.PersonaId, req.Persona)
+
+	var resp *pb.AssignTicketResponse
+	var released store.References
+	err := s.unitOfWork.Run(ctx, func(ctx context.Context) error {
+		ticket, err := s.tickets.GetByNumero(ctx, req.TicketNumero)
+		if err != nil {
+			return notFound(err, "Ticket no encontrado")
+		}
+		owner, ok, err := resolveRef(ctx, store.Ref{ID: req.PersonaId, Nombre: req.Persona}, "persona", s.personaByID, s.personaByNombre)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return status.Error(codes.NotFound, "Persona no encontrada")
+		}
+
+		before := proto.Clone(ticket).(*pb.Ticket)
+		ticket.OwnerId, ticket.Owner = owner.ID, owner.Nombre
+		ticket.Huerfano = false
+		if err := s.tickets.Update(ctx, ticket, ownerFields); err != nil {
+			return err
+		}
+
+		resp = &pb.AssignTicketResponse{}
+		if resp.Ticket, err = s.tickets.Get(ctx, ticket.Id); err != nil {
+			return err
+		}
+		// Se quita el ticket de todas las personas, no solo del dueño
+		// anterior, por si quedó en más de una lista
+		if released, err = s.syncOwner(ctx, before, resp.Ticket); err != nil {
+			return err
+		}
+		if resp.Persona, err = s.personas.Get(ctx, owner.ID); err != nil {
+			return err
+		}
+		return s.recordEvent(ctx, pb.TicketEventType_TICKET_EVENT_TYPE_OWNER_CHANGED, before, resp.Ticket, ownerFields)
+	})
+	if err == nil {
+		resolver := s.newRefResolver()
+		err = errors.Join(resolver.Tickets(ctx, resp.Ticket), resolver.Personas(ctx, resp.Persona))
+	}
+	if err != nil {
+		log.Printf("Error al asignar el ticket: %v", err)
+		return nil, txError(err, "", "Error al asignar el ticket")
+	}
+
+	log.Printf("Ticket %d asignado a %s. Personas que lo tenían: %d", req.TicketNumero, resp.Persona.Nombre, released.Personas)
+	return resp, nil
+}
+
+// UnassignTicket - Maneja la solicitud para dejar un ticket sin dueño. En una
+// transacción borra el owner del ticket y lo quita de los tickets de todas las
+// personas.
+func (s *server) UnassignTicket(ctx context.Context, req *pb.UnassignTicketRequest) (*pb.UnassignTicketResponse, error) {
+	log.Printf("Quitando el dueño del ticket %d", req.TicketNumero)
+
+	var resp *pb.UnassignTicketResponse
+	err := s.unitOfWork.Run(ctx, func(ctx context.Context) error {
+		ticket, err := s.tickets.GetByNumero(ctx, req.TicketNumero)
+		if err != nil {
+			return notFound(err, "Ticket no encontrado")
+		}
+
+		before := proto.Clone(ticket).(*pb.Ticket)
+		ticket.OwnerId, ticket.Owner = "", ""
+		ticket.Huerfano = false
+		if err := s.tickets.Update(ctx, ticket, ownerFields); err != nil {
+			return err
+		}
+
+		resp = &pb.UnassignTicketResponse{}
+		if resp.Ticket, err = s.tickets.Get(ctx, ticket.Id); err != nil {
+			return err
+		}
+		released, err := s.syncOwner(ctx, before, resp.Ticket)
+		if err != nil {
+			return err
+		}
+		resp.Personas = int32(released.Personas)
+		return s.recordEvent(ctx, pb.TicketEventType_TICKET_EVENT_TYPE_OWNER_CHANGED, before, resp.Ticket, ownerFields)
+	})
+	if err == nil {
+		err = s.newRefResolver().Tickets(ctx, resp.Ticket)
+	}
+	if err != nil {
+		log.Printf("Error al quitar el dueño del ticket: %v", err)
+		return nil, txError(err, "", "Error al quitar el dueño del ticket")
+	}
+
+	log.Printf("Ticket %d sin dueño. Personas que lo tenían: %d", req.TicketNumero, resp.Personas)
+	return resp, nil
+}
+
+// RenamePersona - Maneja la solicitud para cambiar el nombre de una persona. En
+// una transacción actualiza la persona y la copia del nombre en el owner de
+// sus tickets y en los colaboradores de los proyectos.
+func (s *server) RenamePersona(ctx context.Context, req *pb.RenamePersonaRequest) (*pb.RenamePersonaResponse, error) {
+	log.Printf("Renombrando persona con ID: %s, Versión: %d, Nombre nuevo: %s", req.Id, req.Version, req.NuevoNombre)
+
+	var resp *pb.RenamePersonaResponse
+	err := s.unitOfWork.Run(ctx, func(ctx context.Context) error {
+		persona, err := s.personas.Get(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		// Los clientes que todavía usan los nombres como referencia no
+		// podrían distinguir a las dos personas
+		other, err := s.personas.GetByNombre(ctx, req.NuevoNombre)
+		switch {
+		case err == nil && other.Id != persona.Id:
+			return status.Errorf(codes.AlreadyExists, "Ya existe una persona llamada %s", req.NuevoNombre)
+		case err != nil && !errors.Is(err, store.ErrNotFound):
+			return err
+		}
+
+		ref := personaRef(persona)
+		persona.Nombre = req.NuevoNombre
+		persona.Version = req.Version
+		if err := s.personas.Update(ctx, persona, []string{"nombre"}); err != nil {
+			return err
+		}
+		refs, err := s.references.ReplacePersona(ctx, ref, req.NuevoNombre)
+		if err != nil {
+			return err
+		}
+
+		resp = &pb.RenamePersonaResponse{Tickets: int32(refs.Tickets), Proyectos: int32(refs.Proyectos)}
+		resp.Persona, err = s.personas.Get(ctx, persona.Id)
+		return err
+	})
+	if err == nil {
+		err = s.newRefResolver().Personas(ctx, resp.Persona)
+	}
+	if err != nil {
+		log.Printf("Error al renombrar la persona: %v", err)
+		return nil, txError(err, "Persona no encontrada", "Error al renombrar la persona")
+	}
+
+	log.Printf("Persona renombrada. Tickets actualizados: %d, Proyectos actualizados: %d", resp.Tickets, resp.Proyectos)
+	return resp, nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	pb "go-grpc-mongo/proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+// statusName devuelve el nombre del estado en la configuración: el valor del
+// enum sin el prefijo y en minúsculas (TICKET_STATUS_IN_PROGRESS es in_progress)
+func statusName(s pb.TicketStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "TICKET_STATUS_"))
+}
+
+// transitionAllowed indica si la configuración permite pasar de from a to
+func (s *server) transitionAllowed(from, to pb.TicketStatus) bool {
+	return slices.Contains(s.cfg.Tickets.Transitions[statusName(from)], statusName(to))
+}
+
+// transitionError informa la transición rechazada y los estados a los que sí
+// se puede pasar
+func (s *server) transitionError(ticket *pb.Ticket, to pb.TicketStatus) error {
+	allowed := s.cfg.Tickets.Transitions[statusName(ticket.Status)]
+	description := fmt.Sprintf("El ticket %d no puede pasar de %s a %s", ticket.TicketNumero, statusName(ticket.Status), statusName(to))
+	if len(allowed) == 0 {
+		description += fmt.Sprintf(" (%s es un estado final)", statusName(ticket.Status))
+	} else {
+		description += fmt.Sprintf(" (estados permitidos: %s)", strings.Join(allowed, ", "))
+	}
+	return preconditionError(description, []*errdetails.PreconditionFailure_Violation{{
+		Type:        "INVALID_TRANSITION",
+		Subject:     "tickets/" + ticket.Id,
+		Description: description,
+	}})
+}
+
+// TransitionTicket - Maneja la solicitud para cambiar el estado de un ticket.
+// Solo se permiten las transiciones de tickets.transitions; el resto falla
+// con FAILED_PRECONDITION.
+func (s *server) TransitionTicket(ctx context.Context, req *pb.TransitionTicketRequest) (*pb.TransitionTicketResponse, error) {
+	log.Printf("Cambiando estado del ticket con ID: %s, Versión: %d, Estado nuevo: %s", req.Id, req.Version, req.Status)
+
+	var resp *pb.TransitionTicketResponse
+	err := s.unitOfWork.RunIfSupported(ctx, func(ctx context.Context) error {
+		ticket, err := s.tickets.Get(ctx, req.Id)
+		if err != nil {
+			return notFound(err, "Ticket no encontrado")
+		}
+		if req.Version != ticket.Version {
+			return versionError(ticket.Version)
+		}
+		if !s.transitionAllowed(ticket.Status, req.Status) {
+			return s.transitionError(ticket, req.Status)
+		}
+
+		resp = &pb.TransitionTicketResponse{PreviousStatus: ticket.Status}
+		updated := proto.Clone(ticket).(*pb.Ticket)
+		updated.Status, updated.Version = req.Status, req.Version
+		if err := s.tickets.Update(ctx, updated, []string{"status"}); err != nil {
+			return err
+		}
+		if resp.Ticket, err = s.tickets.Get(ctx, ticket.Id); err != nil {
+			return err
+		}
+		return s.recordEvent(ctx, pb.TicketEventType_TICKET_EVENT_TYPE_STATUS_CHANGED, ticket, resp.Ticket, []string{"status"})
+	})
+	if err == nil {
+		err = s.newRefResolver().Tickets(ctx, resp.Ticket)
+	}
+	if err != nil {
+		log.Printf("Error al cambiar el estado del ticket: %v", err)
+		return nil, txError(err, "Ticket no encontrado", "Error al cambiar el estado del ticket")
+	}
+
+	log.Printf("Ticket %d pasó de %s a %s", resp.Ticket.TicketNumero, resp.PreviousStatus, resp.Ticket.Status)
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"go-grpc-mongo/config"
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// versionlessTickets simula tickets creados antes de las versiones: MongoDB
+// los devuelve con versión 0 y los acepta con versión 0 (ver versionFilter),
+// aunque el store en memoria los guarde con la versión inicial
+type versionlessTickets struct {
+	store.TicketRepository
+}
+
+func (r versionlessTickets) Get(ctx context.Context, id string) (*pb.Ticket, error) {
+	ticket, err := r.TicketRepository.Get(ctx, id)
+	if err == nil {
+		ticket.Version -= store.InitialVersion
+	}
+	return ticket, err
+}
+
+func (r versionlessTickets) Update(ctx context.Context, ticket *pb.Ticket, fields []string) error {
+	ticket = proto.Clone(ticket).(*pb.Ticket)
+	ticket.Version += store.InitialVersion
+	return r.TicketRepository.Update(ctx, ticket, fields)
+}
+
+func TestTransitionVersionlessTicket(t *testing.T) {
+	ctx := context.Background()
+	st := store.NewMemoryStore()
+	st.Tickets = versionlessTickets{st.Tickets}
+	s := newServer(st, config.Default())
+
+	id, err := st.Tickets.Create(ctx, &pb.Ticket{TicketNumero: 1, Status: pb.TicketStatus_TICKET_STATUS_OPEN})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	legacy, err := st.Tickets.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if legacy.Version != 0 {
+		t.Fatalf("versión del ticket = %d, se esperaba 0", legacy.Version)
+	}
+
+	tests := []struct {
+		name    string
+		version int64
+		want    codes.Code
+	}{
+		{"versión equivocada", 1, codes.FailedPrecondition},
+		{"versión 0", 0, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.TransitionTicketRequest{Id: id, Version: tt.version, Status: pb.TicketStatus_TICKET_STATUS_IN_PROGRESS}
+			if violations := requestRules.Check(req); len(violations) > 0 {
+				t.Fatalf("Check() = %v, se esperaba que la solicitud sea válida", violations)
+			}
+			resp, err := s.TransitionTicket(ctx, req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("TransitionTicket() código = %v (%v), se esperaba %v", got, err, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			if resp.Ticket.Status != pb.TicketStatus_TICKET_STATUS_IN_PROGRESS || resp.Ticket.Version != 1 {
+				t.Errorf("ticket = %v, se esperaba in_progress con versión 1", resp.Ticket)
+			}
+		})
+	}
+
+	if violations := requestRules.Check(&pb.TransitionTicketRequest{Id: id, Version: -1, Status: pb.TicketStatus_TICKET_STATUS_CLOSED}); len(violations) == 0 {
+		t.Error("Check() aceptó una versión negativa")
+	}
+	events, _, err := st.History.List(ctx, id, store.Page{Size: 10})
+	if err != nil {
+		t.Fatalf("History.List: %v", err)
+	}
+	if len(events) != 1 || events[0].Type != pb.TicketEventType_TICKET_EVENT_TYPE_STATUS_CHANGED {
+		t.Errorf("eventos = %v, se esperaba un cambio de estado", events)
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"slices"
+
+	"go-grpc-mongo/model"
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+	"go-grpc-mongo/validate"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Límites de los campos que se guardan
+const (
+	maxNombreLength      = 100
+	maxEdad              = 150
+	maxTitleLength       = 200
+	maxDescriptionLength = 10000
+	maxCommentLength     = 10000
+)
+
+// nivelDificultad acepta los valores históricos de nivel_dificultad que se
+// pueden traducir a un nivel del enum
+var nivelDificultad = validate.Satisfies("nivel_dificultad", "debe ser fácil, medio o difícil",
+	func(v protoreflect.Value) bool {
+		_, ok := model.ParseDificultad(v.String())
+		return ok
+	})
+
+// notNegative acepta 0, que en los campos opcionales significa sin valor
+func notNegative(name string) validate.Rule {
+	return validate.Satisfies(name, "no puede ser negativo",
+		func(v protoreflect.Value) bool { return v.Int() >= 0 })
+}
+
+// ticketsReadOnly rechaza personas.tickets en las altas y los reemplazos: la
+// lista sigue al dueño de cada ticket y la modifican AssignTicket,
+// UnassignTicket, UpdateTicket y DeletePersona
+var ticketsReadOnly = validate.Satisfies("tickets", "no se puede escribir; usar AssignTicket o UnassignTicket",
+	func(v protoreflect.Value) bool { return v.List().Len() == 0 })
+
+// personaMaskable son los campos de UpdatePersona que se pueden elegir con
+// update_mask, más tickets para que ticketsReadOnly no se aplique cuando la
+// máscara no lo incluye (si lo incluye, la máscara es inválida)
+var personaMaskable = append(slices.Clone(store.PersonaUpdateFields), "tickets")
+
+// ticketNumeroOpcional acepta ticket_numero vacío, que pide un número de la
+// secuencia
+var ticketNumeroOpcional = notNegative("ticket_numero")
+
+// requestRules son las reglas que el interceptor de validación aplica a cada
+// solicitud antes de llamar al handler. En los Update* con update_mask solo
+// se verifican los campos de la máscara y los que no se pueden actualizar, como id.
+var requestRules = validate.Rules{
+	validate.For(&pb.CreatePersonaRequest{},
+		validate.Required("nombre"),
+		validate.MaxLength("nombre", maxNombreLength),
+		validate.Range("edad", 0, maxEdad),
+		ticketsReadOnly,
+	),
+	validate.ForUpdate(&pb.UpdatePersonaRequest{}, personaMaskable,
+		validate.Required("id"),
+		validate.Required("nombre"),
+		validate.MaxLength("nombre", maxNombreLength),
+		validate.Range("edad", 0, maxEdad),
+		ticketsReadOnly,
+	),
+	validate.For(&pb.DeletePersonaRequest{},
+		validate.Required("id"),
+	),
+	validate.For(&pb.CreateTicketRequest{},
+		ticketNumeroOpcional,
+		validate.MaxLength("title", maxTitleLength),
+		validate.MaxLength("description", maxDescriptionLength),
+		validate.Defined("priority"),
+	),
+	validate.ForUpdate(&pb.UpdateTicketRequest{}, store.TicketUpdateFields,
+		validate.Required("id"),
+		validate.Positive("ticket_numero"),
+		validate.MaxLength("title", maxTitleLength),
+		validate.MaxLength("description", maxDescriptionLength),
+		validate.Defined("priority"),
+	),
+	validate.For(&pb.DeleteTicketRequest{},
+		validate.Required("id"),
+	),
+	validate.For(&pb.CreateProyectoRequest{},
+		validate.Required("nombre"),
+		validate.MaxLength("nombre", maxNombreLength),
+		validate.Defined("dificultad"),
+		nivelDificultad,
+	),
+	validate.ForUpdate(&pb.UpdateProyectoRequest{}, store.ProyectoUpdateFields,
+		validate.Required("id"),
+		validate.Required("nombre"),
+		validate.MaxLength("nombre", maxNombreLength),
+		validate.Defined("dificultad"),
+		nivelDificultad,
+	),
+	validate.For(&pb.DeleteProyectoRequest{},
+		validate.Required("id"),
+	),
+	validate.For(&pb.GetPersonasByAgeRangeRequest{},
+		validate.Range("edadMinima", 0, maxEdad),
+		validate.Range("edadMaxima", 0, maxEdad),
+		validate.NotGreater("edadMinima", "edadMaxima"),
+	),
+	validate.For(&pb.GetPersonaByNombreRequest{},
+		validate.Required("nombre"),
+	),
+	validate.For(&pb.GetTicketPorNumeroRequest{},
+		validate.Positive("ticket_numero"),
+	),
+	validate.For(&pb.GetTicketPorDuenoRequest{},
+		validate.AnyRequired("owner_id", "dueno"),
+	),
+	validate.For(&pb.ListTicketsByOwnerRequest{},
+		validate.AnyRequired("owner_id", "dueno"),
+		notNegative("ticket_numero_min"),
+		notNegative("ticket_numero_max"),
+	),
+	validate.For(&pb.GetProyectoPorColaboradorRequest{},
+		validate.AnyRequired("colaborador_id", "colaborador"),
+	),
+	validate.For(&pb.GetColaboradoresPorProyectoRequest{},
+		validate.Required("nombre_proyecto"),
+	),
+	validate.For(&pb.AssignTicketRequest{},
+		validate.Positive("ticket_numero"),
+		validate.AnyRequired("persona_id", "persona"),
+	),
+	validate.For(&pb.UnassignTicketRequest{},
+		validate.Positive("ticket_numero"),
+	),
+	validate.For(&pb.TransitionTicketRequest{},
+		validate.Required("id"),
+		// Los tickets creados antes de las versiones tienen versión 0
+		notNegative("version"),
+		validate.Specified("status"),
+		validate.Defined("status"),
+	),
+	validate.For(&pb.RenamePersonaRequest{},
+		validate.Required("id"),
+		validate.Required("nuevo_nombre"),
+		validate.MaxLength("nuevo_nombre", maxNombreLength),
+	),
+	validate.For(&pb.AddTicketCommentRequest{},
+		validate.Required("ticket_id"),
+		validate.MaxLength("author", maxNombreLength),
+		validate.Required("body"),
+		validate.MaxLength("body", maxCommentLength),
+	),
+	validate.For(&pb.ListTicketCommentsRequest{},
+		validate.Required("ticket_id"),
+	),
+	validate.For(&pb.GetTicketHistoryRequest{},
+		validate.Required("ticket_id"),
+	),
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/store"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// personas.tickets sigue al dueño de cada ticket, así que las altas y
+// modificaciones de personas no pueden escribirlo
+func TestPersonaTicketsReadOnly(t *testing.T) {
+	const id = "64b7f0a1c2d3e4f5a6b7c8d9"
+	mask := func(paths ...string) *fieldmaskpb.FieldMask { return &fieldmaskpb.FieldMask{Paths: paths} }
+	tests := []struct {
+		name string
+		req  proto.Message
+		want []string // campos con violaciones
+	}{
+		{"alta sin tickets", &pb.CreatePersonaRequest{Nombre: "Pedro"}, nil},
+		{"alta con tickets", &pb.CreatePersonaRequest{Nombre: "Pedro", Tickets: []int32{104}}, []string{"tickets"}},
+		{"reemplazo con tickets", &pb.UpdatePersonaRequest{Id: id, Nombre: "Pedro", Tickets: []int32{104}}, []string{"tickets"}},
+		{"máscara con tickets", &pb.UpdatePersonaRequest{Id: id, Tickets: []int32{104}, UpdateMask: mask("tickets")}, []string{"tickets"}},
+		// Un cliente puede reenviar la persona que leyó si la máscara no incluye tickets
+		{"máscara sin tickets", &pb.UpdatePersonaRequest{Id: id, Edad: 30, Tickets: []int32{104}, UpdateMask: mask("edad")}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, v := range requestRules.Check(tt.req) {
+				got = append(got, v.Field)
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+				t.Errorf("Check() campos = %v, se esperaba %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := updateFields(mask("tickets"), store.PersonaUpdateFields); err == nil {
+		t.Error("updateFields() aceptó tickets en update_mask")
+	}
+}
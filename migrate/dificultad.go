@@ -0,0 +1,56 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go-grpc-mongo/model"
+	pb "go-grpc-mongo/proto"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// dificultadEnum completa dificultad a partir de nivel_dificultad y normaliza
+// nivel_dificultad al nombre del nivel ("alta", "Alta", "high" y "3" pasan a
+// ser "difícil"). Los valores que no se reconocen quedan como están, con
+// dificultad DIFICULTAD_UNSPECIFIED.
+var dificultadEnum = Func{
+	Description: "proyectos.dificultad: completar a partir de nivel_dificultad y normalizar nivel_dificultad",
+	UpFunc: func(ctx context.Context, t Target) error {
+		collection := t.Collection("proyectos")
+		niveles, err := collection.Distinct(ctx, "nivel_dificultad", bson.M{"dificultad": bson.M{"$exists": false}})
+		if err != nil {
+			return err
+		}
+
+		var unknown []string
+		for _, nivel := range niveles {
+			dificultad, ok := model.ParseDificultad(fmt.Sprint(nivel))
+			set := bson.M{"dificultad": dificultad}
+			if !ok {
+				unknown = append(unknown, fmt.Sprint(nivel))
+			} else if dificultad != pb.Dificultad_DIFICULTAD_UNSPECIFIED {
+				set["nivel_dificultad"] = model.NombreDificultad(dificultad)
+			}
+			filter := bson.M{"nivel_dificultad": nivel, "dificultad": bson.M{"$exists": false}}
+			if _, err := collection.UpdateMany(ctx, filter, bson.M{"$set": set}); err != nil {
+				return err
+			}
+		}
+		// Los documentos sin nivel_dificultad no aparecen en Distinct
+		_, err = collection.UpdateMany(ctx, bson.M{"dificultad": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"dificultad": pb.Dificultad_DIFICULTAD_UNSPECIFIED}})
+		if err != nil {
+			return err
+		}
+		if len(unknown) > 0 {
+			log.Printf("  proyectos: valores de nivel_dificultad que no se reconocen y quedan sin dificultad: %q", unknown)
+		}
+		return nil
+	},
+	DownFunc: func(ctx context.Context, t Target) error {
+		_, err := t.Collection("proyectos").UpdateMany(ctx, bson.M{"dificultad": bson.M{"$exists": true}}, bson.M{"$unset": bson.M{"dificultad": ""}})
+		return err
+	},
+}
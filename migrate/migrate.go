@@ -0,0 +1,371 @@
+// Package migrate aplica migraciones versionadas al esquema de la base de
+// datos. Cada migración aplicada queda registrada en la colección
+// schema_migrations, de modo que se puede avanzar, revertir o ver qué haría
+// una migración (dry-run) sin modificar los datos.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"go-grpc-mongo/config"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MigrationsCollection es la colección donde se registran las migraciones aplicadas
+const MigrationsCollection = "schema_migrations"
+
+// lockID es el _id del documento que impide que dos procesos migren a la vez
+const lockID = "lock"
+
+// failedID es el _id del documento que registra una migración que falló a
+// mitad de camino
+const failedID = "failed"
+
+// ErrLocked se devuelve cuando otro proceso está aplicando migraciones
+var ErrLocked = errors.New("migrate: hay otra migración en curso; si no es así, revisar los datos según el paso que indica el documento {_id: \"lock\"} de " + MigrationsCollection + " y borrarlo")
+
+// Failure - Migración que falló después de aplicar (o revertir) algunos de
+// sus pasos. Mientras esté registrada no se aplican ni revierten migraciones,
+// porque los pasos ya aplicados se volverían a ejecutar.
+type Failure struct {
+	Version  int       `bson:"version"`
+	Name     string    `bson:"name"`
+	Up       bool      `bson:"up"`
+	Applied  int       `bson:"applied"` // pasos que se completaron antes del error
+	Steps    int       `bson:"steps"`
+	Reason   string    `bson:"error"`
+	FailedAt time.Time `bson:"failed_at"`
+}
+
+func (f *Failure) Error() string {
+	action := "aplicar"
+	if !f.Up {
+		action = "revertir"
+	}
+	step := fmt.Sprintf("el paso %d de %d", f.Applied+1, f.Steps)
+	if f.Applied == f.Steps {
+		step = "el registro, con todos los pasos completos"
+	}
+	return fmt.Sprintf("migrate: la migración %d (%s) falló al %s, en %s: %s; revisar los datos y ejecutar migrate resolve (o migrate -completed resolve)",
+		f.Version, f.Name, action, step, f.Reason)
+}
+
+// Target - Base de datos sobre la que se aplican las migraciones
+type Target struct {
+	Database    *mongo.Database
+	Collections config.Collections
+}
+
+// Collection devuelve la colección configurada para personas, tickets,
+// proyectos o ticket_counters; cualquier otro nombre se usa tal cual
+func (t Target) Collection(name string) *mongo.Collection {
+	switch name {
+	case "personas":
+		return t.Database.Collection(t.Collections.Personas)
+	case "tickets":
+		return t.Database.Collection(t.Collections.Tickets)
+	case "proyectos":
+		return t.Database.Collection(t.Collections.Proyectos)
+	case "ticket_counters":
+		return t.Database.Collection(t.Collections.TicketCounters)
+	}
+	return t.Database.Collection(name)
+}
+
+// Migration - Cambio versionado del esquema. Los pasos se aplican en orden al
+// avanzar y en orden inverso al revertir.
+type Migration struct {
+	Version int
+	Name    string
+	Steps   []Step
+}
+
+// Status - Estado de una migración conocida
+type Status struct {
+	Migration Migration
+	AppliedAt time.Time // cero si no se aplicó
+}
+
+// record - Documento de schema_migrations de una migración aplicada
+type record struct {
+	Version   int       `bson:"_id"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+// Runner aplica y revierte migraciones sobre un Target
+type Runner struct {
+	target     Target
+	migrations []Migration
+	// DryRun muestra lo que haría cada paso sin modificar la base de datos
+	DryRun bool
+}
+
+// New valida que las versiones sean positivas y no se repitan, y ordena las migraciones
+func New(target Target, migrations []Migration) (*Runner, error) {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	for i, m := range sorted {
+		if m.Version <= 0 {
+			return nil, fmt.Errorf("migrate: la migración %q tiene una versión inválida %d", m.Name, m.Version)
+		}
+		if i > 0 && sorted[i-1].Version == m.Version {
+			return nil, fmt.Errorf("migrate: la versión %d está repetida (%q y %q)", m.Version, sorted[i-1].Name, m.Name)
+		}
+	}
+	return &Runner{target: target, migrations: sorted}, nil
+}
+
+func (r *Runner) collection() *mongo.Collection {
+	return r.target.Database.Collection(MigrationsCollection)
+}
+
+// applied devuelve las migraciones registradas por versión
+func (r *Runner) applied(ctx context.Context) (map[int]record, error) {
+	cursor, err := r.collection().Find(ctx, bson.M{"_id": bson.M{"$type": "number"}})
+	if err != nil {
+		return nil, err
+	}
+	var records []record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+	result := make(map[int]record, len(records))
+	for _, rec := range records {
+		result[rec.Version] = rec
+	}
+	return result, nil
+}
+
+// Failure devuelve la migración que quedó a medias, o nil si no hay ninguna
+func (r *Runner) Failure(ctx context.Context) (*Failure, error) {
+	var failure Failure
+	err := r.collection().FindOne(ctx, bson.M{"_id": failedID}).Decode(&failure)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &failure, nil
+}
+
+// Resolve borra el registro de una migración que quedó a medias, después de
+// revisar los datos a mano. Con completed, los pasos que faltaban se
+// completaron a mano y la migración se registra como aplicada (o revertida,
+// si falló al revertir). Sin completed, los pasos aplicados se deshicieron y
+// el próximo up o down vuelve a ejecutar la migración desde el primer paso.
+// Devuelve el registro borrado, o nil si no había ninguno.
+func (r *Runner) Resolve(ctx context.Context, completed bool) (*Failure, error) {
+	failure, err := r.Failure(ctx)
+	if err != nil || failure == nil {
+		return nil, err
+	}
+	if completed {
+		if err := r.record(ctx, Migration{Version: failure.Version, Name: failure.Name}, failure.Up); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := r.collection().DeleteOne(ctx, bson.M{"_id": failedID}); err != nil {
+		return nil, err
+	}
+	return failure, nil
+}
+
+// Status devuelve todas las migraciones conocidas y cuándo se aplicó cada una
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, len(r.migrations))
+	for i, m := range r.migrations {
+		statuses[i] = Status{Migration: m, AppliedAt: applied[m.Version].AppliedAt}
+	}
+	return statuses, nil
+}
+
+// Up aplica en orden las migraciones pendientes hasta la versión to inclusive;
+// to 0 significa todas
+func (r *Runner) Up(ctx context.Context, to int) error {
+	return r.withLock(ctx, func(applied map[int]record) error {
+		pending := 0
+		for _, m := range r.migrations {
+			if _, ok := applied[m.Version]; ok || (to > 0 && m.Version > to) {
+				continue
+			}
+			pending++
+			if err := r.run(ctx, m, true); err != nil {
+				return err
+			}
+		}
+		if pending == 0 {
+			log.Println("No hay migraciones pendientes")
+		}
+		return nil
+	})
+}
+
+// Down revierte en orden inverso las migraciones aplicadas con versión mayor
+// que to; to negativo revierte solo la última aplicada
+func (r *Runner) Down(ctx context.Context, to int) error {
+	return r.withLock(ctx, func(applied map[int]record) error {
+		reverted := 0
+		for i := len(r.migrations) - 1; i >= 0; i-- {
+			m := r.migrations[i]
+			if _, ok := applied[m.Version]; !ok || (to >= 0 && m.Version <= to) {
+				continue
+			}
+			if err := r.run(ctx, m, false); err != nil {
+				return err
+			}
+			reverted++
+			if to < 0 {
+				break
+			}
+		}
+		if reverted == 0 {
+			log.Println("No hay migraciones para revertir")
+		}
+		return nil
+	})
+}
+
+// withLock toma el lock de migraciones (salvo en dry-run) y llama a fn con
+// las migraciones aplicadas. Si una migración quedó a medias devuelve su
+// Failure sin llamar a fn; en dry-run solo lo informa.
+func (r *Runner) withLock(ctx context.Context, fn func(applied map[int]record) error) error {
+	if !r.DryRun {
+		_, err := r.collection().InsertOne(ctx, bson.M{"_id": lockID, "locked_at": time.Now()})
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrLocked
+		}
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if _, err := r.collection().DeleteOne(context.WithoutCancel(ctx), bson.M{"_id": lockID}); err != nil {
+				log.Printf("Error al liberar el lock de migraciones: %v", err)
+			}
+		}()
+	}
+
+	failure, err := r.Failure(ctx)
+	if err != nil {
+		return err
+	}
+	if failure != nil {
+		if !r.DryRun {
+			return failure
+		}
+		log.Printf("Atención: %v", failure)
+	}
+
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return err
+	}
+	return fn(applied)
+}
+
+// run aplica (up) o revierte una migración y actualiza su registro. Si un
+// paso falla, o falla el registro, guarda un Failure con los pasos que se
+// completaron para que no se vuelvan a ejecutar sin revisar los datos.
+func (r *Runner) run(ctx context.Context, m Migration, up bool) error {
+	action := "Aplicando"
+	if !up {
+		action = "Revirtiendo"
+	}
+	if r.DryRun {
+		action += " (dry-run)"
+	}
+	log.Printf("%s migración %d: %s", action, m.Version, m.Name)
+
+	steps := m.Steps
+	if !up {
+		steps = make([]Step, len(m.Steps))
+		for i, step := range m.Steps {
+			steps[len(m.Steps)-1-i] = step
+		}
+	}
+	for i, step := range steps {
+		if r.DryRun {
+			plan, err := step.Plan(ctx, r.target, up)
+			if err != nil {
+				return fmt.Errorf("migración %d (%s): %w", m.Version, m.Name, err)
+			}
+			log.Printf("  %s", plan)
+			continue
+		}
+
+		// El lock guarda el paso en curso, por si el proceso termina sin
+		// poder registrar la falla
+		if _, err := r.collection().UpdateOne(ctx, bson.M{"_id": lockID}, bson.M{"$set": bson.M{
+			"version": m.Version, "name": m.Name, "up": up, "applied": i,
+		}}); err != nil {
+			return err
+		}
+		var err error
+		if up {
+			err = step.Up(ctx, r.target)
+		} else {
+			err = step.Down(ctx, r.target)
+		}
+		if err != nil {
+			return r.fail(ctx, m, up, i, err)
+		}
+	}
+	if r.DryRun {
+		return nil
+	}
+
+	if err := r.record(ctx, m, up); err != nil {
+		return r.fail(ctx, m, up, len(steps), err)
+	}
+	return nil
+}
+
+// record registra la migración como aplicada (up) o la quita del registro
+func (r *Runner) record(ctx context.Context, m Migration, up bool) error {
+	if up {
+		rec := record{Version: m.Version, Name: m.Name, AppliedAt: time.Now().UTC()}
+		_, err := r.collection().ReplaceOne(ctx, bson.M{"_id": m.Version}, rec, options.Replace().SetUpsert(true))
+		return err
+	}
+	_, err := r.collection().DeleteOne(ctx, bson.M{"_id": m.Version})
+	return err
+}
+
+// fail registra que la migración m falló después de completar applied pasos y
+// devuelve el Failure. Si tampoco se puede registrar devuelve los dos errores.
+func (r *Runner) fail(ctx context.Context, m Migration, up bool, applied int, err error) error {
+	failure := &Failure{
+		Version:  m.Version,
+		Name:     m.Name,
+		Up:       up,
+		Applied:  applied,
+		Steps:    len(m.Steps),
+		Reason:   err.Error(),
+		FailedAt: time.Now().UTC(),
+	}
+	doc := struct {
+		ID       string `bson:"_id"`
+		*Failure `bson:",inline"`
+	}{failedID, failure}
+	// El contexto puede estar cancelado, que es justamente por lo que falló el paso
+	if _, recordErr := r.collection().InsertOne(context.WithoutCancel(ctx), doc); recordErr != nil {
+		return errors.Join(
+			fmt.Errorf("migración %d (%s): %w", m.Version, m.Name, err),
+			fmt.Errorf("migrate: no se pudo registrar la falla; revisar los datos antes de volver a migrar: %w", recordErr),
+		)
+	}
+	return failure
+}
@@ -0,0 +1,57 @@
+package migrate
+
+import pb "go-grpc-mongo/proto"
+
+// Migrations es la lista de migraciones del esquema de argentina_office. Las
+// versiones nuevas se agregan al final; una versión ya publicada no se modifica.
+var Migrations = []Migration{
+	{
+		// Los datos de ejemplo de db.InsertDummyData y los documentos cargados
+		// a mano no tienen todos los campos del proto, y proyectoFromBSON falla
+		// si falta nivel_dificultad
+		Version: 1,
+		Name:    "campos_por_defecto",
+		Steps: []Step{
+			Backfill{Collection: "personas", Field: "tickets", Value: []int32{}},
+			Backfill{Collection: "personas", Field: "proyecto", Value: ""},
+			Backfill{Collection: "tickets", Field: "owner", Value: ""},
+			Backfill{Collection: "proyectos", Field: "colaboradores", Value: []string{}},
+			Backfill{Collection: "proyectos", Field: "nivel_dificultad", Value: ""},
+		},
+	},
+	{
+		// Las referencias pasan a guardarse por ID; el nombre queda como copia
+		// para mostrar. Al revertir se pierden los IDs escritos desde entonces.
+		Version: 2,
+		Name:    "referencias_por_id",
+		Steps:   []Step{referenciasPorID},
+	},
+	{
+		// nivel_dificultad pasa a ser el enum Dificultad, que se guarda como
+		// número para filtrar y ordenar. Al revertir, nivel_dificultad conserva
+		// el nombre normalizado y no la variante original.
+		Version: 3,
+		Name:    "dificultad_enum",
+		Steps:   []Step{dificultadEnum},
+	},
+	{
+		// Los tickets pasan a tener estado, prioridad y timestamps. Los que ya
+		// existen quedan abiertos y sin prioridad.
+		Version: 4,
+		Name:    "estado_de_tickets",
+		Steps: []Step{
+			Backfill{Collection: "tickets", Field: "status", Value: pb.TicketStatus_TICKET_STATUS_OPEN},
+			Backfill{Collection: "tickets", Field: "priority", Value: pb.TicketPriority_TICKET_PRIORITY_UNSPECIFIED},
+			Backfill{Collection: "tickets", Field: "title", Value: ""},
+			Backfill{Collection: "tickets", Field: "description", Value: ""},
+			timestampsDesdeID,
+		},
+	},
+	{
+		// Los tickets creados sin ticket_numero se numeran con un contador.
+		// Las demás secuencias empiezan en su primer número configurado.
+		Version: 5,
+		Name:    "contador_de_tickets",
+		Steps:   []Step{contadorDesdeTickets},
+	},
+}
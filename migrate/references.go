@@ -0,0 +1,134 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go-grpc-mongo/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// referenciasPorID completa owner_id, proyecto_id y colaborador_ids a partir
+// de los nombres. Los nombres que no corresponden a ningún documento quedan
+// sin ID (o con "" en su posición de colaborador_ids) y siguen funcionando
+// como referencias por nombre.
+var referenciasPorID = Func{
+	Description: "tickets.owner_id, personas.proyecto_id y proyectos.colaborador_ids: completar a partir de los nombres",
+	UpFunc: func(ctx context.Context, t Target) error {
+		personas, err := nameIndex(ctx, t.Collection("personas"))
+		if err != nil {
+			return err
+		}
+		proyectos, err := nameIndex(ctx, t.Collection("proyectos"))
+		if err != nil {
+			return err
+		}
+
+		if err := backfillID(ctx, t.Collection("tickets"), "owner", "owner_id", personas); err != nil {
+			return err
+		}
+		if err := backfillID(ctx, t.Collection("personas"), "proyecto", "proyecto_id", proyectos); err != nil {
+			return err
+		}
+		return backfillColaboradorIDs(ctx, t.Collection("proyectos"), personas)
+	},
+	DownFunc: func(ctx context.Context, t Target) error {
+		for collection, field := range map[string]string{
+			"tickets":   "owner_id",
+			"personas":  "proyecto_id",
+			"proyectos": "colaborador_ids",
+		} {
+			_, err := t.Collection(collection).UpdateMany(ctx, bson.M{field: bson.M{"$exists": true}}, bson.M{"$unset": bson.M{field: ""}})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+// nameIndex devuelve el ID de cada nombre de la colección. Si un nombre se
+// repite se usa el documento con el menor _id.
+func nameIndex(ctx context.Context, collection *mongo.Collection) (map[string]string, error) {
+	opts := options.Find().SetProjection(bson.M{"nombre": 1}).SetSort(bson.D{{Key: "_id", Value: -1}})
+	cursor, err := collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []struct {
+		ID     model.ID `bson:"_id"`
+		Nombre string   `bson:"nombre"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]string, len(docs))
+	for _, doc := range docs {
+		index[doc.Nombre] = doc.ID.String()
+	}
+	return index, nil
+}
+
+// backfillID completa idField en los documentos que tienen nameField y todavía
+// no tienen idField
+func backfillID(ctx context.Context, collection *mongo.Collection, nameField, idField string, index map[string]string) error {
+	filter := bson.M{nameField: bson.M{"$nin": bson.A{nil, ""}}, idField: bson.M{"$exists": false}}
+	names, err := collection.Distinct(ctx, nameField, filter)
+	if err != nil {
+		return err
+	}
+
+	var missing int
+	for _, name := range names {
+		id, ok := index[fmt.Sprint(name)]
+		if !ok {
+			missing++
+			continue
+		}
+		named := bson.M{nameField: name, idField: bson.M{"$exists": false}}
+		if _, err := collection.UpdateMany(ctx, named, bson.M{"$set": bson.M{idField: id}}); err != nil {
+			return err
+		}
+	}
+	if missing > 0 {
+		log.Printf("  %s: %d valores de %s no corresponden a ningún documento y quedan sin %s", collection.Name(), missing, nameField, idField)
+	}
+	return nil
+}
+
+// backfillColaboradorIDs completa colaborador_ids en el mismo orden que
+// colaboradores, con "" para los nombres que no corresponden a ninguna persona
+func backfillColaboradorIDs(ctx context.Context, collection *mongo.Collection, personas map[string]string) error {
+	cursor, err := collection.Find(ctx, bson.M{"colaborador_ids": bson.M{"$exists": false}},
+		options.Find().SetProjection(bson.M{"colaboradores": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID            model.ID `bson:"_id"`
+			Colaboradores []string `bson:"colaboradores"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		ids := make([]string, len(doc.Colaboradores))
+		for i, nombre := range doc.Colaboradores {
+			ids[i] = personas[nombre]
+		}
+		_, err := collection.UpdateOne(ctx,
+			bson.M{"_id": doc.ID, "colaborador_ids": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"colaborador_ids": ids}})
+		if err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
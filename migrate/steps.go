@@ -0,0 +1,104 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Step es una operación reversible de una migración
+type Step interface {
+	// Plan describe lo que haría el paso y cuántos documentos afectaría
+	Plan(ctx context.Context, t Target, up bool) (string, error)
+	Up(ctx context.Context, t Target) error
+	Down(ctx context.Context, t Target) error
+}
+
+// Backfill agrega Field con Value a los documentos que no lo tienen. Al
+// revertir borra Field de los documentos en los que vale Value, por lo que
+// también se borra si un documento tenía ese valor antes de migrar.
+type Backfill struct {
+	Collection string
+	Field      string
+	Value      interface{}
+}
+
+func (b Backfill) missing() bson.M { return bson.M{b.Field: bson.M{"$exists": false}} }
+func (b Backfill) filled() bson.M  { return bson.M{b.Field: b.Value} }
+
+func (b Backfill) Plan(ctx context.Context, t Target, up bool) (string, error) {
+	if up {
+		n, err := t.Collection(b.Collection).CountDocuments(ctx, b.missing())
+		return fmt.Sprintf("%s: agregar %s = %v en %d documentos", b.Collection, b.Field, b.Value, n), err
+	}
+	n, err := t.Collection(b.Collection).CountDocuments(ctx, b.filled())
+	return fmt.Sprintf("%s: quitar %s en %d documentos donde vale %v", b.Collection, b.Field, n, b.Value), err
+}
+
+func (b Backfill) Up(ctx context.Context, t Target) error {
+	_, err := t.Collection(b.Collection).UpdateMany(ctx, b.missing(), bson.M{"$set": bson.M{b.Field: b.Value}})
+	return err
+}
+
+func (b Backfill) Down(ctx context.Context, t Target) error {
+	_, err := t.Collection(b.Collection).UpdateMany(ctx, b.filled(), bson.M{"$unset": bson.M{b.Field: ""}})
+	return err
+}
+
+// RenameField renombra From a To en los documentos que tienen From. Los
+// documentos que ya tienen To no se modifican, para no pisar datos.
+type RenameField struct {
+	Collection string
+	From       string
+	To         string
+}
+
+func rename(from, to string) bson.M {
+	return bson.M{from: bson.M{"$exists": true}, to: bson.M{"$exists": false}}
+}
+
+func (r RenameField) Plan(ctx context.Context, t Target, up bool) (string, error) {
+	from, to := r.From, r.To
+	if !up {
+		from, to = to, from
+	}
+	n, err := t.Collection(r.Collection).CountDocuments(ctx, rename(from, to))
+	return fmt.Sprintf("%s: renombrar %s a %s en %d documentos", r.Collection, from, to, n), err
+}
+
+func (r RenameField) Up(ctx context.Context, t Target) error {
+	_, err := t.Collection(r.Collection).UpdateMany(ctx, rename(r.From, r.To), bson.M{"$rename": bson.M{r.From: r.To}})
+	return err
+}
+
+func (r RenameField) Down(ctx context.Context, t Target) error {
+	_, err := t.Collection(r.Collection).UpdateMany(ctx, rename(r.To, r.From), bson.M{"$rename": bson.M{r.To: r.From}})
+	return err
+}
+
+// Func es un paso con lógica propia. En dry-run solo se muestra Description.
+type Func struct {
+	Description string
+	UpFunc      func(ctx context.Context, t Target) error
+	DownFunc    func(ctx context.Context, t Target) error
+}
+
+func (f Func) Plan(ctx context.Context, t Target, up bool) (string, error) {
+	if !up && f.DownFunc == nil {
+		return f.Description + " (no se revierte)", nil
+	}
+	return f.Description, nil
+}
+
+func (f Func) Up(ctx context.Context, t Target) error {
+	return f.UpFunc(ctx, t)
+}
+
+// Down no hace nada si el paso no define DownFunc
+func (f Func) Down(ctx context.Context, t Target) error {
+	if f.DownFunc == nil {
+		return nil
+	}
+	return f.DownFunc(ctx, t)
+}
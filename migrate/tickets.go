@@ -0,0 +1,60 @@
+package migrate
+
+import (
+	"context"
+
+	"go-grpc-mongo/config"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// timestampsDesdeID completa created_at y updated_at de los tickets anteriores
+// a los timestamps con la fecha de creación que guarda su ObjectID. Los
+// tickets con un _id string quedan sin fechas.
+var timestampsDesdeID = Func{
+	Description: "tickets.created_at y updated_at: completar con la fecha del ObjectID",
+	UpFunc: func(ctx context.Context, t Target) error {
+		filter := bson.M{"_id": bson.M{"$type": "objectId"}, "created_at": bson.M{"$exists": false}}
+		pipeline := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"created_at": bson.M{"$toDate": "$_id"},
+			"updated_at": bson.M{"$ifNull": bson.A{"$updated_at", bson.M{"$toDate": "$_id"}}},
+		}}}}
+		_, err := t.Collection("tickets").UpdateMany(ctx, filter, pipeline)
+		return err
+	},
+	DownFunc: func(ctx context.Context, t Target) error {
+		// Solo se revierten las fechas que coinciden con la del ObjectID
+		filter := bson.M{"$expr": bson.M{"$eq": bson.A{"$created_at", bson.M{"$toDate": "$_id"}}}}
+		_, err := t.Collection("tickets").UpdateMany(ctx, filter, bson.M{"$unset": bson.M{"created_at": "", "updated_at": ""}})
+		return err
+	},
+}
+
+// contadorDesdeTickets lleva el contador de la secuencia por defecto al mayor
+// ticket_numero existente, para que los tickets nuevos no tengan que saltear
+// los números ya usados. Un contador que ya va más adelante no cambia. Al
+// revertir el contador se conserva, para no volver a asignar números.
+var contadorDesdeTickets = Func{
+	Description: "ticket_counters." + config.DefaultTicketSequence + ": continuar desde el mayor tickets.ticket_numero",
+	UpFunc: func(ctx context.Context, t Target) error {
+		var last struct {
+			TicketNumero int32 `bson:"ticket_numero"`
+		}
+		opts := options.FindOne().SetSort(bson.D{{Key: "ticket_numero", Value: -1}}).SetProjection(bson.M{"ticket_numero": 1})
+		err := t.Collection("tickets").FindOne(ctx, bson.M{}, opts).Decode(&last)
+		if err == mongo.ErrNoDocuments {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		pipeline := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"value": bson.M{"$max": bson.A{"$value", last.TicketNumero}},
+		}}}}
+		_, err = t.Collection("ticket_counters").UpdateOne(ctx, bson.M{"_id": config.DefaultTicketSequence}, pipeline, options.Update().SetUpsert(true))
+		return err
+	},
+}
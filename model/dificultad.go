@@ -0,0 +1,61 @@
+package model
+
+import (
+	"strings"
+
+	pb "go-grpc-mongo/proto"
+)
+
+// nivelesDificultad relaciona cada valor histórico de nivel_dificultad con su
+// nivel. Las claves están en minúsculas y sin tildes; ver normalizarNivel.
+var nivelesDificultad = map[string]pb.Dificultad{
+	"facil": pb.Dificultad_DIFICULTAD_FACIL,
+	"baja":  pb.Dificultad_DIFICULTAD_FACIL,
+	"bajo":  pb.Dificultad_DIFICULTAD_FACIL,
+	"low":   pb.Dificultad_DIFICULTAD_FACIL,
+	"easy":  pb.Dificultad_DIFICULTAD_FACIL,
+	"1":     pb.Dificultad_DIFICULTAD_FACIL,
+
+	"medio":  pb.Dificultad_DIFICULTAD_MEDIO,
+	"media":  pb.Dificultad_DIFICULTAD_MEDIO,
+	"medium": pb.Dificultad_DIFICULTAD_MEDIO,
+	"2":      pb.Dificultad_DIFICULTAD_MEDIO,
+
+	"dificil": pb.Dificultad_DIFICULTAD_DIFICIL,
+	"alta":    pb.Dificultad_DIFICULTAD_DIFICIL,
+	"alto":    pb.Dificultad_DIFICULTAD_DIFICIL,
+	"high":    pb.Dificultad_DIFICULTAD_DIFICIL,
+	"hard":    pb.Dificultad_DIFICULTAD_DIFICIL,
+	"3":       pb.Dificultad_DIFICULTAD_DIFICIL,
+}
+
+// nombresDificultad es el nivel_dificultad que se escribe para cada nivel
+var nombresDificultad = map[pb.Dificultad]string{
+	pb.Dificultad_DIFICULTAD_FACIL:   "fácil",
+	pb.Dificultad_DIFICULTAD_MEDIO:   "medio",
+	pb.Dificultad_DIFICULTAD_DIFICIL: "difícil",
+}
+
+var sinTildes = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u")
+
+func normalizarNivel(nivel string) string {
+	return sinTildes.Replace(strings.ToLower(strings.TrimSpace(nivel)))
+}
+
+// ParseDificultad interpreta un nivel_dificultad guardado o enviado por un
+// cliente anterior al enum. Acepta los nombres en español e inglés sin
+// distinguir mayúsculas ni tildes, y los números 1 a 3. Un string vacío es
+// DIFICULTAD_UNSPECIFIED; ok es false si el valor no se reconoce.
+func ParseDificultad(nivel string) (dificultad pb.Dificultad, ok bool) {
+	if strings.TrimSpace(nivel) == "" {
+		return pb.Dificultad_DIFICULTAD_UNSPECIFIED, true
+	}
+	dificultad, ok = nivelesDificultad[normalizarNivel(nivel)]
+	return dificultad, ok
+}
+
+// NombreDificultad devuelve el nivel_dificultad de un nivel: "fácil", "medio"
+// o "difícil", o vacío si no está especificado
+func NombreDificultad(dificultad pb.Dificultad) string {
+	return nombresDificultad[dificultad]
+}
@@ -0,0 +1,249 @@
+package model
+
+import (
+	"time"
+
+	pb "go-grpc-mongo/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Los campos que faltan en un documento quedan con el valor cero: los datos
+// de ejemplo y los documentos cargados a mano no siempre tienen todos. Los
+// números guardados como double (lo habitual desde mongosh) se aceptan si no
+// tienen decimales.
+
+// Persona - Una persona tal como se guarda en la colección personas
+type Persona struct {
+	ID         ID      `bson:"_id,omitempty"`
+	Nombre     string  `bson:"nombre"`
+	Edad       int32   `bson:"edad"`
+	Tickets    []int32 `bson:"tickets"`
+	Proyecto   string  `bson:"proyecto"`
+	ProyectoID string  `bson:"proyecto_id"`
+	Version    int64   `bson:"version"`
+}
+
+// PersonaFromProto convierte el mensaje de gRPC en el documento. El ID no se
+// copia: lo asigna MongoDB al insertar.
+func PersonaFromProto(p *pb.Persona) Persona {
+	return Persona{
+		Nombre:     p.Nombre,
+		Edad:       p.Edad,
+		Tickets:    p.Tickets,
+		Proyecto:   p.Proyecto,
+		ProyectoID: p.ProyectoId,
+		Version:    p.Version,
+	}
+}
+
+func (d Persona) ToProto() *pb.Persona {
+	return &pb.Persona{
+		Id:         d.ID.String(),
+		Nombre:     d.Nombre,
+		Edad:       d.Edad,
+		Tickets:    d.Tickets,
+		Proyecto:   d.Proyecto,
+		ProyectoId: d.ProyectoID,
+		Version:    d.Version,
+	}
+}
+
+// Ticket - Un ticket tal como se guarda en la colección tickets. El estado y
+// la prioridad se guardan como el número del enum.
+type Ticket struct {
+	ID           ID                `bson:"_id,omitempty"`
+	TicketNumero int32             `bson:"ticket_numero"`
+	Owner        string            `bson:"owner"`
+	OwnerID      string            `bson:"owner_id"`
+	Version      int64             `bson:"version"`
+	Huerfano     bool              `bson:"huerfano"`
+	Status       pb.TicketStatus   `bson:"status"`
+	Priority     pb.TicketPriority `bson:"priority"`
+	Title        string            `bson:"title"`
+	Description  string            `bson:"description"`
+	CreatedAt    time.Time         `bson:"created_at,omitempty"`
+	UpdatedAt    time.Time         `bson:"updated_at,omitempty"`
+}
+
+// TicketFromProto convierte el mensaje de gRPC en el documento, sin el ID
+func TicketFromProto(t *pb.Ticket) Ticket {
+	return Ticket{
+		TicketNumero: t.TicketNumero,
+		Owner:        t.Owner,
+		OwnerID:      t.OwnerId,
+		Version:      t.Version,
+		Huerfano:     t.Huerfano,
+		Status:       t.Status,
+		Priority:     t.Priority,
+		Title:        t.Title,
+		Description:  t.Description,
+		CreatedAt:    fromTimestamp(t.CreatedAt),
+		UpdatedAt:    fromTimestamp(t.UpdatedAt),
+	}
+}
+
+// ToProto considera abiertos a los tickets anteriores al estado
+func (d Ticket) ToProto() *pb.Ticket {
+	status := d.Status
+	if status == pb.TicketStatus_TICKET_STATUS_UNSPECIFIED {
+		status = pb.TicketStatus_TICKET_STATUS_OPEN
+	}
+	return &pb.Ticket{
+		Id:           d.ID.String(),
+		TicketNumero: d.TicketNumero,
+		Owner:        d.Owner,
+		OwnerId:      d.OwnerID,
+		Version:      d.Version,
+		Huerfano:     d.Huerfano,
+		Status:       status,
+		Priority:     d.Priority,
+		Title:        d.Title,
+		Description:  d.Description,
+		CreatedAt:    toTimestamp(d.CreatedAt),
+		UpdatedAt:    toTimestamp(d.UpdatedAt),
+	}
+}
+
+// Proyecto - Un proyecto tal como se guarda en la colección proyectos
+type Proyecto struct {
+	ID              ID       `bson:"_id,omitempty"`
+	Nombre          string   `bson:"nombre"`
+	Colaboradores   []string `bson:"colaboradores"`
+	ColaboradorIDs  []string `bson:"colaborador_ids"`
+	NivelDificultad string   `bson:"nivel_dificultad"`
+	// Dificultad es el nivel como número, para filtrar y ordenar. Los
+	// documentos anteriores al enum solo tienen nivel_dificultad.
+	Dificultad pb.Dificultad `bson:"dificultad"`
+	Version    int64         `bson:"version"`
+}
+
+// ProyectoFromProto convierte el mensaje de gRPC en el documento, sin el ID
+func ProyectoFromProto(p *pb.Proyecto) Proyecto {
+	return Proyecto{
+		Nombre:          p.Nombre,
+		Colaboradores:   p.Colaboradores,
+		ColaboradorIDs:  p.ColaboradorIds,
+		NivelDificultad: p.NivelDificultad,
+		Dificultad:      p.Dificultad,
+		Version:         p.Version,
+	}
+}
+
+// ToProto completa el nivel de los documentos anteriores al enum a partir de
+// nivel_dificultad, y devuelve nivel_dificultad con el nombre del nivel. Un
+// nivel_dificultad que no se reconoce se devuelve tal cual, sin nivel.
+func (d Proyecto) ToProto() *pb.Proyecto {
+	dificultad, nivel := d.Dificultad, d.NivelDificultad
+	if dificultad == pb.Dificultad_DIFICULTAD_UNSPECIFIED {
+		dificultad, _ = ParseDificultad(nivel)
+	}
+	if nombre := NombreDificultad(dificultad); nombre != "" {
+		nivel = nombre
+	}
+	return &pb.Proyecto{
+		Id:              d.ID.String(),
+		Nombre:          d.Nombre,
+		Colaboradores:   d.Colaboradores,
+		ColaboradorIds:  d.ColaboradorIDs,
+		NivelDificultad: nivel,
+		Dificultad:      dificultad,
+		Version:         d.Version,
+	}
+}
+
+// TicketComment - Un comentario tal como se guarda en la colección de
+// comentarios de tickets
+type TicketComment struct {
+	ID        ID        `bson:"_id,omitempty"`
+	TicketID  string    `bson:"ticket_id"`
+	Author    string    `bson:"author"`
+	Body      string    `bson:"body"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// TicketCommentFromProto convierte el mensaje de gRPC en el documento, sin el ID
+func TicketCommentFromProto(c *pb.TicketComment) TicketComment {
+	return TicketComment{
+		TicketID:  c.TicketId,
+		Author:    c.Author,
+		Body:      c.Body,
+		CreatedAt: fromTimestamp(c.CreatedAt),
+	}
+}
+
+func (d TicketComment) ToProto() *pb.TicketComment {
+	return &pb.TicketComment{
+		Id:        d.ID.String(),
+		TicketId:  d.TicketID,
+		Author:    d.Author,
+		Body:      d.Body,
+		CreatedAt: toTimestamp(d.CreatedAt),
+	}
+}
+
+// TicketEvent - Un evento tal como se guarda en la colección del historial de
+// tickets. El tipo se guarda como el número del enum.
+type TicketEvent struct {
+	ID           ID                 `bson:"_id,omitempty"`
+	TicketID     string             `bson:"ticket_id"`
+	TicketNumero int32              `bson:"ticket_numero"`
+	Type         pb.TicketEventType `bson:"type"`
+	Actor        string             `bson:"actor"`
+	CreatedAt    time.Time          `bson:"created_at"`
+	Changes      []FieldChange      `bson:"changes"`
+}
+
+// FieldChange - Valor de un campo antes y después de un evento
+type FieldChange struct {
+	Field  string `bson:"field"`
+	Before string `bson:"before"`
+	After  string `bson:"after"`
+}
+
+// TicketEventFromProto convierte el mensaje de gRPC en el documento, sin el ID
+func TicketEventFromProto(e *pb.TicketEvent) TicketEvent {
+	changes := make([]FieldChange, len(e.Changes))
+	for i, c := range e.Changes {
+		changes[i] = FieldChange{Field: c.Field, Before: c.Before, After: c.After}
+	}
+	return TicketEvent{
+		TicketID:     e.TicketId,
+		TicketNumero: e.TicketNumero,
+		Type:         e.Type,
+		Actor:        e.Actor,
+		CreatedAt:    fromTimestamp(e.CreatedAt),
+		Changes:      changes,
+	}
+}
+
+func (d TicketEvent) ToProto() *pb.TicketEvent {
+	changes := make([]*pb.FieldChange, len(d.Changes))
+	for i, c := range d.Changes {
+		changes[i] = &pb.FieldChange{Field: c.Field, Before: c.Before, After: c.After}
+	}
+	return &pb.TicketEvent{
+		Id:           d.ID.String(),
+		TicketId:     d.TicketID,
+		TicketNumero: d.TicketNumero,
+		Type:         d.Type,
+		Actor:        d.Actor,
+		CreatedAt:    toTimestamp(d.CreatedAt),
+		Changes:      changes,
+	}
+}
+
+// toTimestamp devuelve nil si t es cero, es decir, si el documento no tiene el campo
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
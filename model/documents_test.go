@@ -0,0 +1,135 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	pb "go-grpc-mongo/proto"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ids son los dos tipos de _id que se encuentran en las colecciones: el
+// ObjectID que genera el driver y el string de los documentos cargados a mano
+var ids = []struct {
+	name string
+	raw  interface{}
+	want string
+}{
+	{"ObjectID", mustObjectID("64b7f0a1c2d3e4f5a6b7c8d9"), "64b7f0a1c2d3e4f5a6b7c8d9"},
+	{"string", "persona-legacy-1", "persona-legacy-1"},
+}
+
+func mustObjectID(hex string) primitive.ObjectID {
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// roundTrip guarda doc como BSON y lo vuelve a leer, como hacen los
+// repositorios de MongoDB
+func roundTrip[D any](t *testing.T, doc D) D {
+	t.Helper()
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		t.Fatalf("bson.Marshal: %v", err)
+	}
+	var decoded D
+	if err := bson.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("bson.Unmarshal: %v", err)
+	}
+	return decoded
+}
+
+// checkID verifica que el _id conserve su tipo, para poder volver a usarlo en
+// filtros
+func checkID(t *testing.T, got ID, raw interface{}) {
+	t.Helper()
+	if got.Value() != raw {
+		t.Errorf("_id = %#v, se esperaba %#v", got.Value(), raw)
+	}
+}
+
+func TestPersonaRoundTrip(t *testing.T) {
+	for _, id := range ids {
+		t.Run(id.name, func(t *testing.T) {
+			want := &pb.Persona{
+				Id:         id.want,
+				Nombre:     "Pedro",
+				Edad:       34,
+				Tickets:    []int32{104, 105, 106},
+				Proyecto:   "Proyecto Alpha",
+				ProyectoId: "64b7f0a1c2d3e4f5a6b7c8aa",
+				Version:    3,
+			}
+			doc := PersonaFromProto(want)
+			doc.ID = IDOf(id.raw)
+
+			decoded := roundTrip(t, doc)
+			checkID(t, decoded.ID, id.raw)
+			if got := decoded.ToProto(); !proto.Equal(got, want) {
+				t.Errorf("ToProto() = %v, se esperaba %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTicketRoundTrip(t *testing.T) {
+	// MongoDB guarda las fechas con precisión de milisegundos
+	created := time.Date(2024, 3, 1, 10, 30, 0, 123000000, time.UTC)
+	for _, id := range ids {
+		t.Run(id.name, func(t *testing.T) {
+			want := &pb.Ticket{
+				Id:           id.want,
+				TicketNumero: 104,
+				Owner:        "Pedro",
+				OwnerId:      "64b7f0a1c2d3e4f5a6b7c8bb",
+				Version:      2,
+				Huerfano:     true,
+				Status:       pb.TicketStatus_TICKET_STATUS_IN_PROGRESS,
+				Priority:     pb.TicketPriority_TICKET_PRIORITY_HIGH,
+				Title:        "Login roto",
+				Description:  "No se puede iniciar sesión",
+				CreatedAt:    timestamppb.New(created),
+				UpdatedAt:    timestamppb.New(created.Add(time.Hour)),
+			}
+			doc := TicketFromProto(want)
+			doc.ID = IDOf(id.raw)
+
+			decoded := roundTrip(t, doc)
+			checkID(t, decoded.ID, id.raw)
+			if got := decoded.ToProto(); !proto.Equal(got, want) {
+				t.Errorf("ToProto() = %v, se esperaba %v", got, want)
+			}
+		})
+	}
+}
+
+func TestProyectoRoundTrip(t *testing.T) {
+	for _, id := range ids {
+		t.Run(id.name, func(t *testing.T) {
+			want := &pb.Proyecto{
+				Id:              id.want,
+				Nombre:          "Proyecto Alpha",
+				Colaboradores:   []string{"Pedro", "Carlos"},
+				ColaboradorIds:  []string{"64b7f0a1c2d3e4f5a6b7c8bb", "persona-legacy-2"},
+				NivelDificultad: NombreDificultad(pb.Dificultad_DIFICULTAD_MEDIO),
+				Dificultad:      pb.Dificultad_DIFICULTAD_MEDIO,
+				Version:         5,
+			}
+			doc := ProyectoFromProto(want)
+			doc.ID = IDOf(id.raw)
+
+			decoded := roundTrip(t, doc)
+			checkID(t, decoded.ID, id.raw)
+			if got := decoded.ToProto(); !proto.Equal(got, want) {
+				t.Errorf("ToProto() = %v, se esperaba %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Package model define cómo se guardan personas, tickets y proyectos en
+// MongoDB: los documentos tipados de cada colección y su conversión a los
+// mensajes de gRPC. Todos los repositorios de MongoDB leen y escriben a través
+// de estos tipos, de modo que un campo se decodifica igual en cada consulta.
+package model
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ID - _id de un documento. Los documentos creados por el servidor tienen un
+// ObjectID y los cargados a mano pueden tener un string; ID acepta ambos,
+// conserva el valor original para volver a escribirlo y lo convierte en el
+// mismo string que se envía por gRPC.
+type ID struct {
+	raw interface{}
+}
+
+// IDOf envuelve un _id ya leído de MongoDB, por ejemplo el InsertedID
+func IDOf(raw interface{}) ID {
+	return ID{raw: raw}
+}
+
+// RawID lee solo el _id de un documento, aunque el resto no se pueda decodificar
+func RawID(doc bson.Raw) ID {
+	var id ID
+	if value, err := doc.LookupErr("_id"); err == nil {
+		_ = id.UnmarshalBSONValue(value.Type, value.Value)
+	}
+	return id
+}
+
+// String devuelve el ID que se envía por gRPC: el hexadecimal de un ObjectID
+// o el string tal cual
+func (id ID) String() string {
+	switch v := id.raw.(type) {
+	case nil:
+		return ""
+	case primitive.ObjectID:
+		return v.Hex()
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Value devuelve el _id original, para usarlo en filtros y tokens de página
+func (id ID) Value() interface{} {
+	return id.raw
+}
+
+// IsZero indica que el documento todavía no tiene _id. Con omitempty, el
+// driver genera un ObjectID al insertarlo.
+func (id ID) IsZero() bool {
+	return id.raw == nil
+}
+
+func (id ID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(id.raw)
+}
+
+func (id *ID) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	var raw interface{}
+	if err := bson.UnmarshalValue(t, data, &raw); err != nil {
+		return err
+	}
+	id.raw = raw
+	return nil
+}
+
+// IDValues devuelve los valores de _id que corresponden a los IDs recibidos
+// por gRPC: el ObjectID si el ID es hexadecimal y además el string, por los
+// documentos cargados a mano
+func IDValues(ids ...string) bson.A {
+	values := bson.A{}
+	for _, id := range ids {
+		if objID, err := primitive.ObjectIDFromHex(id); err == nil {
+			values = append(values, objID)
+		}
+		values = append(values, id)
+	}
+	return values
+}
@@ -10,6 +10,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,16 +23,252 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TicketStatus - Estado de un ticket. Las transiciones permitidas entre
+// estados se configuran en el servidor (tickets.transitions).
+type TicketStatus int32
+
+const (
+	TicketStatus_TICKET_STATUS_UNSPECIFIED TicketStatus = 0
+	TicketStatus_TICKET_STATUS_OPEN        TicketStatus = 1
+	TicketStatus_TICKET_STATUS_IN_PROGRESS TicketStatus = 2
+	TicketStatus_TICKET_STATUS_BLOCKED     TicketStatus = 3
+	TicketStatus_TICKET_STATUS_RESOLVED    TicketStatus = 4
+	TicketStatus_TICKET_STATUS_CLOSED      TicketStatus = 5
+)
+
+// Enum value maps for TicketStatus.
+var (
+	TicketStatus_name = map[int32]string{
+		0: "TICKET_STATUS_UNSPECIFIED",
+		1: "TICKET_STATUS_OPEN",
+		2: "TICKET_STATUS_IN_PROGRESS",
+		3: "TICKET_STATUS_BLOCKED",
+		4: "TICKET_STATUS_RESOLVED",
+		5: "TICKET_STATUS_CLOSED",
+	}
+	TicketStatus_value = map[string]int32{
+		"TICKET_STATUS_UNSPECIFIED": 0,
+		"TICKET_STATUS_OPEN":        1,
+		"TICKET_STATUS_IN_PROGRESS": 2,
+		"TICKET_STATUS_BLOCKED":     3,
+		"TICKET_STATUS_RESOLVED":    4,
+		"TICKET_STATUS_CLOSED":      5,
+	}
+)
+
+func (x TicketStatus) Enum() *TicketStatus {
+	p := new(TicketStatus)
+	*p = x
+	return p
+}
+
+func (x TicketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[0].Descriptor()
+}
+
+func (TicketStatus) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[0]
+}
+
+func (x TicketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketStatus.Descriptor instead.
+func (TicketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{0}
+}
+
+// TicketPriority - Prioridad de un ticket, de menor a mayor
+type TicketPriority int32
+
+const (
+	TicketPriority_TICKET_PRIORITY_UNSPECIFIED TicketPriority = 0
+	TicketPriority_TICKET_PRIORITY_LOW         TicketPriority = 1
+	TicketPriority_TICKET_PRIORITY_MEDIUM      TicketPriority = 2
+	TicketPriority_TICKET_PRIORITY_HIGH        TicketPriority = 3
+	TicketPriority_TICKET_PRIORITY_URGENT      TicketPriority = 4
+)
+
+// Enum value maps for TicketPriority.
+var (
+	TicketPriority_name = map[int32]string{
+		0: "TICKET_PRIORITY_UNSPECIFIED",
+		1: "TICKET_PRIORITY_LOW",
+		2: "TICKET_PRIORITY_MEDIUM",
+		3: "TICKET_PRIORITY_HIGH",
+		4: "TICKET_PRIORITY_URGENT",
+	}
+	TicketPriority_value = map[string]int32{
+		"TICKET_PRIORITY_UNSPECIFIED": 0,
+		"TICKET_PRIORITY_LOW":         1,
+		"TICKET_PRIORITY_MEDIUM":      2,
+		"TICKET_PRIORITY_HIGH":        3,
+		"TICKET_PRIORITY_URGENT":      4,
+	}
+)
+
+func (x TicketPriority) Enum() *TicketPriority {
+	p := new(TicketPriority)
+	*p = x
+	return p
+}
+
+func (x TicketPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[1].Descriptor()
+}
+
+func (TicketPriority) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[1]
+}
+
+func (x TicketPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketPriority.Descriptor instead.
+func (TicketPriority) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{1}
+}
+
+// Dificultad - Nivel de dificultad de un proyecto, de menor a mayor. Los
+// clientes que todavía envían nivel_dificultad como string pueden usar los
+// valores históricos ("alta", "Alta", "high", "3", ...); ver el README.
+type Dificultad int32
+
+const (
+	Dificultad_DIFICULTAD_UNSPECIFIED Dificultad = 0 // Sin nivel, o un valor guardado que no se reconoce
+	Dificultad_DIFICULTAD_FACIL       Dificultad = 1
+	Dificultad_DIFICULTAD_MEDIO       Dificultad = 2
+	Dificultad_DIFICULTAD_DIFICIL     Dificultad = 3
+)
+
+// Enum value maps for Dificultad.
+var (
+	Dificultad_name = map[int32]string{
+		0: "DIFICULTAD_UNSPECIFIED",
+		1: "DIFICULTAD_FACIL",
+		2: "DIFICULTAD_MEDIO",
+		3: "DIFICULTAD_DIFICIL",
+	}
+	Dificultad_value = map[string]int32{
+		"DIFICULTAD_UNSPECIFIED": 0,
+		"DIFICULTAD_FACIL":       1,
+		"DIFICULTAD_MEDIO":       2,
+		"DIFICULTAD_DIFICIL":     3,
+	}
+)
+
+func (x Dificultad) Enum() *Dificultad {
+	p := new(Dificultad)
+	*p = x
+	return p
+}
+
+func (x Dificultad) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Dificultad) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[2].Descriptor()
+}
+
+func (Dificultad) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[2]
+}
+
+func (x Dificultad) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Dificultad.Descriptor instead.
+func (Dificultad) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{2}
+}
+
+type TicketEventType int32
+
+const (
+	TicketEventType_TICKET_EVENT_TYPE_UNSPECIFIED    TicketEventType = 0
+	TicketEventType_TICKET_EVENT_TYPE_UPDATED        TicketEventType = 1 // UpdateTicket
+	TicketEventType_TICKET_EVENT_TYPE_OWNER_CHANGED  TicketEventType = 2 // AssignTicket o DeletePersona
+	TicketEventType_TICKET_EVENT_TYPE_STATUS_CHANGED TicketEventType = 3 // TransitionTicket
+)
+
+// Enum value maps for TicketEventType.
+var (
+	TicketEventType_name = map[int32]string{
+		0: "TICKET_EVENT_TYPE_UNSPECIFIED",
+		1: "TICKET_EVENT_TYPE_UPDATED",
+		2: "TICKET_EVENT_TYPE_OWNER_CHANGED",
+		3: "TICKET_EVENT_TYPE_STATUS_CHANGED",
+	}
+	TicketEventType_value = map[string]int32{
+		"TICKET_EVENT_TYPE_UNSPECIFIED":    0,
+		"TICKET_EVENT_TYPE_UPDATED":        1,
+		"TICKET_EVENT_TYPE_OWNER_CHANGED":  2,
+		"TICKET_EVENT_TYPE_STATUS_CHANGED": 3,
+	}
+)
+
+func (x TicketEventType) Enum() *TicketEventType {
+	p := new(TicketEventType)
+	*p = x
+	return p
+}
+
+func (x TicketEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[3].Descriptor()
+}
+
+func (TicketEventType) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[3]
+}
+
+func (x TicketEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketEventType.Descriptor instead.
+func (TicketEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{3}
+}
+
 // Mensajes de solicitud y respuesta para el servicio CreateService
+// idempotency_key (o la metadata idempotency-key) evita crear duplicados
+// cuando el cliente reintenta: una repetición con la misma clave y el mismo
+// payload devuelve el ID original, y con otro payload falla con ALREADY_EXISTS.
+// Las referencias se indican por ID (proyecto_id, owner_id, colaborador_ids).
+// Los campos con el nombre quedan como alias mientras los clientes migran: si
+// se envía solo el nombre, el servidor busca el ID; si se envían ambos deben
+// referirse al mismo documento.
 type CreatePersonaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nombre   string  `protobuf:"bytes,1,opt,name=nombre,proto3" json:"nombre,omitempty"`
-	Edad     int32   `protobuf:"varint,2,opt,name=edad,proto3" json:"edad,omitempty"`
-	Tickets  []int32 `protobuf:"varint,3,rep,packed,name=tickets,proto3" json:"tickets,omitempty"`
-	Proyecto string  `protobuf:"bytes,4,opt,name=proyecto,proto3" json:"proyecto,omitempty"`
+	Nombre string `protobuf:"bytes,1,opt,name=nombre,proto3" json:"nombre,omitempty"`
+	Edad   int32  `protobuf:"varint,2,opt,name=edad,proto3" json:"edad,omitempty"`
+	// Debe estar vacío: los tickets se asignan con AssignTicket o al crearlos
+	//
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	Tickets []int32 `protobuf:"varint,3,rep,packed,name=tickets,proto3" json:"tickets,omitempty"`
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	Proyecto       string `protobuf:"bytes,4,opt,name=proyecto,proto3" json:"proyecto,omitempty"` // Usar proyecto_id
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	ProyectoId     string `protobuf:"bytes,6,opt,name=proyecto_id,json=proyectoId,proto3" json:"proyecto_id,omitempty"`
 }
 
 func (x *CreatePersonaRequest) Reset() {
@@ -77,6 +315,7 @@ func (x *CreatePersonaRequest) GetEdad() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *CreatePersonaRequest) GetTickets() []int32 {
 	if x != nil {
 		return x.Tickets
@@ -84,6 +323,7 @@ func (x *CreatePersonaRequest) GetTickets() []int32 {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *CreatePersonaRequest) GetProyecto() string {
 	if x != nil {
 		return x.Proyecto
@@ -91,12 +331,28 @@ func (x *CreatePersonaRequest) GetProyecto() string {
 	return ""
 }
 
+func (x *CreatePersonaRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreatePersonaRequest) GetProyectoId() string {
+	if x != nil {
+		return x.ProyectoId
+	}
+	return ""
+}
+
+// version es la versión con la que se creó la persona (siempre 1)
 type CreatePersonaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *CreatePersonaResponse) Reset() {
@@ -136,16 +392,36 @@ func (x *CreatePersonaResponse) GetId() string {
 	return ""
 }
 
+func (x *CreatePersonaResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// update_mask indica qué campos se escriben (nombre, edad, tickets,
+// proyecto_id). proyecto y proyecto_id son el mismo campo.
+// Si está vacío o es "*" se reemplazan todos los campos.
+// version debe ser la versión actual de la persona; si no coincide la
+// actualización falla con FAILED_PRECONDITION.
 type UpdatePersonaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nombre   string  `protobuf:"bytes,2,opt,name=nombre,proto3" json:"nombre,omitempty"`
-	Edad     int32   `protobuf:"varint,3,opt,name=edad,proto3" json:"edad,omitempty"`
-	Tickets  []int32 `protobuf:"varint,4,rep,packed,name=tickets,proto3" json:"tickets,omitempty"`
-	Proyecto string  `protobuf:"bytes,5,opt,name=proyecto,proto3" json:"proyecto,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nombre string `protobuf:"bytes,2,opt,name=nombre,proto3" json:"nombre,omitempty"`
+	Edad   int32  `protobuf:"varint,3,opt,name=edad,proto3" json:"edad,omitempty"`
+	// Debe estar vacío y no se puede elegir en update_mask: los tickets de una
+	// persona cambian con AssignTicket, UnassignTicket y UpdateTicket
+	//
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	Tickets []int32 `protobuf:"varint,4,rep,packed,name=tickets,proto3" json:"tickets,omitempty"`
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	Proyecto   string                 `protobuf:"bytes,5,opt,name=proyecto,proto3" json:"proyecto,omitempty"` // Usar proyecto_id
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version    int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	ProyectoId string                 `protobuf:"bytes,8,opt,name=proyecto_id,json=proyectoId,proto3" json:"proyecto_id,omitempty"`
 }
 
 func (x *UpdatePersonaRequest) Reset() {
@@ -199,6 +475,7 @@ func (x *UpdatePersonaRequest) GetEdad() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *UpdatePersonaRequest) GetTickets() []int32 {
 	if x != nil {
 		return x.Tickets
@@ -206,6 +483,7 @@ func (x *UpdatePersonaRequest) GetTickets() []int32 {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *UpdatePersonaRequest) GetProyecto() string {
 	if x != nil {
 		return x.Proyecto
@@ -213,6 +491,27 @@ func (x *UpdatePersonaRequest) GetProyecto() string {
 	return ""
 }
 
+func (x *UpdatePersonaRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePersonaRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdatePersonaRequest) GetProyectoId() string {
+	if x != nil {
+		return x.ProyectoId
+	}
+	return ""
+}
+
 type UpdatePersonaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -258,12 +557,20 @@ func (x *UpdatePersonaResponse) GetSuccess() bool {
 	return false
 }
 
+// Con la política de borrado cascade, los tickets de la persona se reasignan
+// a reassign_tickets_to_id (o reassign_tickets_to) si se indica, o quedan sin dueño y marcados como
+// huérfanos. Con la política block el borrado falla si la persona tiene
+// tickets o es colaboradora de algún proyecto.
 type DeletePersonaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Versión actual de la persona
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	ReassignTicketsTo   string `protobuf:"bytes,3,opt,name=reassign_tickets_to,json=reassignTicketsTo,proto3" json:"reassign_tickets_to,omitempty"`         // Usar reassign_tickets_to_id
+	ReassignTicketsToId string `protobuf:"bytes,4,opt,name=reassign_tickets_to_id,json=reassignTicketsToId,proto3" json:"reassign_tickets_to_id,omitempty"` // ID de la persona que recibe los tickets
 }
 
 func (x *DeletePersonaRequest) Reset() {
@@ -303,6 +610,28 @@ func (x *DeletePersonaRequest) GetId() string {
 	return ""
 }
 
+func (x *DeletePersonaRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/service.proto.
+func (x *DeletePersonaRequest) GetReassignTicketsTo() string {
+	if x != nil {
+		return x.ReassignTicketsTo
+	}
+	return ""
+}
+
+func (x *DeletePersonaRequest) GetReassignTicketsToId() string {
+	if x != nil {
+		return x.ReassignTicketsToId
+	}
+	return ""
+}
+
 type DeletePersonaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -349,13 +678,24 @@ func (x *DeletePersonaResponse) GetSuccess() bool {
 }
 
 // Mensajes para tickets
+// Los tickets se crean en estado TICKET_STATUS_OPEN. Sin ticket_numero el
+// servidor asigna el siguiente número de sequence (la secuencia default si
+// está vacía); un ticket_numero explícito se usa para importar tickets y falla
+// con ALREADY_EXISTS si ya está en uso.
 type CreateTicketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TicketNumero int32  `protobuf:"varint,1,opt,name=ticket_numero,json=ticketNumero,proto3" json:"ticket_numero,omitempty"`
-	Owner        string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	TicketNumero int32 `protobuf:"varint,1,opt,name=ticket_numero,json=ticketNumero,proto3" json:"ticket_numero,omitempty"`
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	Owner          string         `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`                                         // Usar owner_id
+	IdempotencyKey string         `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Ver CreatePersonaRequest
+	OwnerId        string         `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Title          string         `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string         `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Priority       TicketPriority `protobuf:"varint,7,opt,name=priority,proto3,enum=pb.TicketPriority" json:"priority,omitempty"`
+	Sequence       string         `protobuf:"bytes,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (x *CreateTicketRequest) Reset() {
@@ -395,6 +735,7 @@ func (x *CreateTicketRequest) GetTicketNumero() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *CreateTicketRequest) GetOwner() string {
 	if x != nil {
 		return x.Owner
@@ -402,12 +743,56 @@ func (x *CreateTicketRequest) GetOwner() string {
 	return ""
 }
 
+func (x *CreateTicketRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateTicketRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateTicketRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTicketRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTicketRequest) GetPriority() TicketPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TicketPriority_TICKET_PRIORITY_UNSPECIFIED
+}
+
+func (x *CreateTicketRequest) GetSequence() string {
+	if x != nil {
+		return x.Sequence
+	}
+	return ""
+}
+
 type CreateTicketResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version      int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	TicketNumero int32  `protobuf:"varint,3,opt,name=ticket_numero,json=ticketNumero,proto3" json:"ticket_numero,omitempty"` // Número asignado o recibido
 }
 
 func (x *CreateTicketResponse) Reset() {
@@ -447,6 +832,24 @@ func (x *CreateTicketResponse) GetId() string {
 	return ""
 }
 
+func (x *CreateTicketResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CreateTicketResponse) GetTicketNumero() int32 {
+	if x != nil {
+		return x.TicketNumero
+	}
+	return 0
+}
+
+// update_mask indica qué campos se escriben (ticket_numero, owner_id, title,
+// description, priority). owner y owner_id son el mismo campo. Si está vacío
+// o es "*" se reemplazan todos los campos. version debe ser la versión actual
+// del ticket. El estado solo cambia con TransitionTicket.
 type UpdateTicketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -454,7 +857,14 @@ type UpdateTicketRequest struct {
 
 	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	TicketNumero int32  `protobuf:"varint,2,opt,name=ticket_numero,json=ticketNumero,proto3" json:"ticket_numero,omitempty"`
-	Owner        string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	Owner       string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"` // Usar owner_id
+	UpdateMask  *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version     int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	OwnerId     string                 `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Title       string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Priority    TicketPriority         `protobuf:"varint,9,opt,name=priority,proto3,enum=pb.TicketPriority" json:"priority,omitempty"`
 }
 
 func (x *UpdateTicketRequest) Reset() {
@@ -501,6 +911,7 @@ func (x *UpdateTicketRequest) GetTicketNumero() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *UpdateTicketRequest) GetOwner() string {
 	if x != nil {
 		return x.Owner
@@ -508,12 +919,55 @@ func (x *UpdateTicketRequest) GetOwner() string {
 	return ""
 }
 
+func (x *UpdateTicketRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateTicketRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateTicketRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UpdateTicketRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTicketRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTicketRequest) GetPriority() TicketPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TicketPriority_TICKET_PRIORITY_UNSPECIFIED
+}
+
 type DeleteTicketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Versión actual del ticket
 }
 
 func (x *DeleteTicketRequest) Reset() {
@@ -553,15 +1007,27 @@ func (x *DeleteTicketRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteTicketRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Mensajes para proyectos
 type CreateProyectoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nombre          string   `protobuf:"bytes,1,opt,name=nombre,proto3" json:"nombre,omitempty"`
-	Colaboradores   []string `protobuf:"bytes,2,rep,name=colaboradores,proto3" json:"colaboradores,omitempty"`
-	NivelDificultad string   `protobuf:"bytes,3,opt,name=nivel_dificultad,json=nivelDificultad,proto3" json:"nivel_dificultad,omitempty"`
+	Nombre string `protobuf:"bytes,1,opt,name=nombre,proto3" json:"nombre,omitempty"`
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	Colaboradores []string `protobuf:"bytes,2,rep,name=colaboradores,proto3" json:"colaboradores,omitempty"` // Usar colaborador_ids
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	NivelDificultad string     `protobuf:"bytes,3,opt,name=nivel_dificultad,json=nivelDificultad,proto3" json:"nivel_dificultad,omitempty"` // Usar dificultad
+	IdempotencyKey  string     `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`    // Ver CreatePersonaRequest
+	ColaboradorIds  []string   `protobuf:"bytes,5,rep,name=colaborador_ids,json=colaboradorIds,proto3" json:"colaborador_ids,omitempty"`
+	Dificultad      Dificultad `protobuf:"varint,6,opt,name=dificultad,proto3,enum=pb.Dificultad" json:"dificultad,omitempty"`
 }
 
 func (x *CreateProyectoRequest) Reset() {
@@ -601,6 +1067,7 @@ func (x *CreateProyectoRequest) GetNombre() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *CreateProyectoRequest) GetColaboradores() []string {
 	if x != nil {
 		return x.Colaboradores
@@ -608,6 +1075,7 @@ func (x *CreateProyectoRequest) GetColaboradores() []string {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *CreateProyectoRequest) GetNivelDificultad() string {
 	if x != nil {
 		return x.NivelDificultad
@@ -615,12 +1083,34 @@ func (x *CreateProyectoRequest) GetNivelDificultad() string {
 	return ""
 }
 
+func (x *CreateProyectoRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateProyectoRequest) GetColaboradorIds() []string {
+	if x != nil {
+		return x.ColaboradorIds
+	}
+	return nil
+}
+
+func (x *CreateProyectoRequest) GetDificultad() Dificultad {
+	if x != nil {
+		return x.Dificultad
+	}
+	return Dificultad_DIFICULTAD_UNSPECIFIED
+}
+
 type CreateProyectoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *CreateProyectoResponse) Reset() {
@@ -660,15 +1150,32 @@ func (x *CreateProyectoResponse) GetId() string {
 	return ""
 }
 
+func (x *CreateProyectoResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// update_mask indica qué campos se escriben (nombre, colaborador_ids,
+// dificultad). colaboradores y colaborador_ids son el mismo campo, igual que
+// nivel_dificultad y dificultad. Si está vacío o es "*" se reemplazan todos
+// los campos. version debe ser la versión actual del proyecto.
 type UpdateProyectoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nombre          string   `protobuf:"bytes,2,opt,name=nombre,proto3" json:"nombre,omitempty"`
-	Colaboradores   []string `protobuf:"bytes,3,rep,name=colaboradores,proto3" json:"colaboradores,omitempty"`
-	NivelDificultad string   `protobuf:"bytes,4,opt,name=nivel_dificultad,json=nivelDificultad,proto3" json:"nivel_dificultad,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nombre string `protobuf:"bytes,2,opt,name=nombre,proto3" json:"nombre,omitempty"`
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	Colaboradores []string `protobuf:"bytes,3,rep,name=colaboradores,proto3" json:"colaboradores,omitempty"` // Usar colaborador_ids
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	NivelDificultad string                 `protobuf:"bytes,4,opt,name=nivel_dificultad,json=nivelDificultad,proto3" json:"nivel_dificultad,omitempty"` // Usar dificultad
+	UpdateMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version         int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	ColaboradorIds  []string               `protobuf:"bytes,7,rep,name=colaborador_ids,json=colaboradorIds,proto3" json:"colaborador_ids,omitempty"`
+	Dificultad      Dificultad             `protobuf:"varint,8,opt,name=dificultad,proto3,enum=pb.Dificultad" json:"dificultad,omitempty"`
 }
 
 func (x *UpdateProyectoRequest) Reset() {
@@ -715,6 +1222,7 @@ func (x *UpdateProyectoRequest) GetNombre() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *UpdateProyectoRequest) GetColaboradores() []string {
 	if x != nil {
 		return x.Colaboradores
@@ -722,6 +1230,7 @@ func (x *UpdateProyectoRequest) GetColaboradores() []string {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *UpdateProyectoRequest) GetNivelDificultad() string {
 	if x != nil {
 		return x.NivelDificultad
@@ -729,12 +1238,41 @@ func (x *UpdateProyectoRequest) GetNivelDificultad() string {
 	return ""
 }
 
+func (x *UpdateProyectoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateProyectoRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateProyectoRequest) GetColaboradorIds() []string {
+	if x != nil {
+		return x.ColaboradorIds
+	}
+	return nil
+}
+
+func (x *UpdateProyectoRequest) GetDificultad() Dificultad {
+	if x != nil {
+		return x.Dificultad
+	}
+	return Dificultad_DIFICULTAD_UNSPECIFIED
+}
+
 type DeleteProyectoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Versión actual del proyecto
 }
 
 func (x *DeleteProyectoRequest) Reset() {
@@ -774,11 +1312,27 @@ func (x *DeleteProyectoRequest) GetId() string {
 	return ""
 }
 
-// Mensaje vacío para llamadas que no requieren parámetros
+func (x *DeleteProyectoRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Solicitudes paginadas para los listados completos. Si page_size es 0 el
+// servidor usa su tamaño por defecto; page_token es el next_page_token de la
+// respuesta anterior. filter usa la sintaxis de AIP-160, por ejemplo
+// `edad >= 25 AND proyecto = "proyecto alpha"`, y order_by es una lista de
+// campos separados por coma con asc o desc, por ejemplo `edad desc, nombre`.
 type GetPersonasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy   string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 }
 
 func (x *GetPersonasRequest) Reset() {
@@ -811,10 +1365,43 @@ func (*GetPersonasRequest) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{14}
 }
 
-type GetTicketsRequest struct {
+func (x *GetPersonasRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPersonasRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetPersonasRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetPersonasRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type GetTicketsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy   string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 }
 
 func (x *GetTicketsRequest) Reset() {
@@ -847,10 +1434,47 @@ func (*GetTicketsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{15}
 }
 
+func (x *GetTicketsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTicketsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTicketsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetTicketsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+// Los proyectos que no se pueden leer (por ejemplo, con un campo de otro
+// tipo) se omiten y se informan en partial_failures. Con strict la consulta
+// falla con INTERNAL si alguno no se puede leer.
 type GetProyectosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy   string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Strict    bool   `protobuf:"varint,5,opt,name=strict,proto3" json:"strict,omitempty"`
 }
 
 func (x *GetProyectosRequest) Reset() {
@@ -883,6 +1507,150 @@ func (*GetProyectosRequest) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{16}
 }
 
+func (x *GetProyectosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetProyectosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetProyectosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetProyectosRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetProyectosRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+// Solicitudes para los métodos de streaming
+type StreamPersonasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamPersonasRequest) Reset() {
+	*x = StreamPersonasRequest{}
+	mi := &file_proto_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPersonasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPersonasRequest) ProtoMessage() {}
+
+func (x *StreamPersonasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPersonasRequest.ProtoReflect.Descriptor instead.
+func (*StreamPersonasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{17}
+}
+
+type StreamTicketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamTicketsRequest) Reset() {
+	*x = StreamTicketsRequest{}
+	mi := &file_proto_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTicketsRequest) ProtoMessage() {}
+
+func (x *StreamTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTicketsRequest.ProtoReflect.Descriptor instead.
+func (*StreamTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{18}
+}
+
+type StreamProyectosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamProyectosRequest) Reset() {
+	*x = StreamProyectosRequest{}
+	mi := &file_proto_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamProyectosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamProyectosRequest) ProtoMessage() {}
+
+func (x *StreamProyectosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamProyectosRequest.ProtoReflect.Descriptor instead.
+func (*StreamProyectosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{19}
+}
+
 // Solicitudes y respuestas para cada uno de los métodos
 type GetPersonasByAgeRangeRequest struct {
 	state         protoimpl.MessageState
@@ -895,7 +1663,7 @@ type GetPersonasByAgeRangeRequest struct {
 
 func (x *GetPersonasByAgeRangeRequest) Reset() {
 	*x = GetPersonasByAgeRangeRequest{}
-	mi := &file_proto_service_proto_msgTypes[17]
+	mi := &file_proto_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -907,7 +1675,7 @@ func (x *GetPersonasByAgeRangeRequest) String() string {
 func (*GetPersonasByAgeRangeRequest) ProtoMessage() {}
 
 func (x *GetPersonasByAgeRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[17]
+	mi := &file_proto_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,7 +1688,7 @@ func (x *GetPersonasByAgeRangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPersonasByAgeRangeRequest.ProtoReflect.Descriptor instead.
 func (*GetPersonasByAgeRangeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{17}
+	return file_proto_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetPersonasByAgeRangeRequest) GetEdadMinima() int32 {
@@ -947,7 +1715,7 @@ type GetTicketPorNumeroRequest struct {
 
 func (x *GetTicketPorNumeroRequest) Reset() {
 	*x = GetTicketPorNumeroRequest{}
-	mi := &file_proto_service_proto_msgTypes[18]
+	mi := &file_proto_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -959,7 +1727,7 @@ func (x *GetTicketPorNumeroRequest) String() string {
 func (*GetTicketPorNumeroRequest) ProtoMessage() {}
 
 func (x *GetTicketPorNumeroRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[18]
+	mi := &file_proto_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1740,7 @@ func (x *GetTicketPorNumeroRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTicketPorNumeroRequest.ProtoReflect.Descriptor instead.
 func (*GetTicketPorNumeroRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{18}
+	return file_proto_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetTicketPorNumeroRequest) GetTicketNumero() int32 {
@@ -992,7 +1760,7 @@ type GetPersonasPorNumeroDeTicketRequest struct {
 
 func (x *GetPersonasPorNumeroDeTicketRequest) Reset() {
 	*x = GetPersonasPorNumeroDeTicketRequest{}
-	mi := &file_proto_service_proto_msgTypes[19]
+	mi := &file_proto_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1004,7 +1772,7 @@ func (x *GetPersonasPorNumeroDeTicketRequest) String() string {
 func (*GetPersonasPorNumeroDeTicketRequest) ProtoMessage() {}
 
 func (x *GetPersonasPorNumeroDeTicketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[19]
+	mi := &file_proto_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1785,7 @@ func (x *GetPersonasPorNumeroDeTicketRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetPersonasPorNumeroDeTicketRequest.ProtoReflect.Descriptor instead.
 func (*GetPersonasPorNumeroDeTicketRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{19}
+	return file_proto_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetPersonasPorNumeroDeTicketRequest) GetTicketNumero() int32 {
@@ -1037,7 +1805,7 @@ type GetPersonaByNombreRequest struct {
 
 func (x *GetPersonaByNombreRequest) Reset() {
 	*x = GetPersonaByNombreRequest{}
-	mi := &file_proto_service_proto_msgTypes[20]
+	mi := &file_proto_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1817,7 @@ func (x *GetPersonaByNombreRequest) String() string {
 func (*GetPersonaByNombreRequest) ProtoMessage() {}
 
 func (x *GetPersonaByNombreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[20]
+	mi := &file_proto_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1830,7 @@ func (x *GetPersonaByNombreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPersonaByNombreRequest.ProtoReflect.Descriptor instead.
 func (*GetPersonaByNombreRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{20}
+	return file_proto_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetPersonaByNombreRequest) GetNombre() string {
@@ -1072,17 +1840,19 @@ func (x *GetPersonaByNombreRequest) GetNombre() string {
 	return ""
 }
 
+// Se busca por owner_id si se indica, y si no por el nombre del dueño
 type GetTicketPorDuenoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dueno string `protobuf:"bytes,1,opt,name=dueno,proto3" json:"dueno,omitempty"`
+	Dueno   string `protobuf:"bytes,1,opt,name=dueno,proto3" json:"dueno,omitempty"`
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 }
 
 func (x *GetTicketPorDuenoRequest) Reset() {
 	*x = GetTicketPorDuenoRequest{}
-	mi := &file_proto_service_proto_msgTypes[21]
+	mi := &file_proto_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1094,7 +1864,7 @@ func (x *GetTicketPorDuenoRequest) String() string {
 func (*GetTicketPorDuenoRequest) ProtoMessage() {}
 
 func (x *GetTicketPorDuenoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[21]
+	mi := &file_proto_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1877,7 @@ func (x *GetTicketPorDuenoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTicketPorDuenoRequest.ProtoReflect.Descriptor instead.
 func (*GetTicketPorDuenoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{21}
+	return file_proto_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetTicketPorDuenoRequest) GetDueno() string {
@@ -1117,29 +1887,44 @@ func (x *GetTicketPorDuenoRequest) GetDueno() string {
 	return ""
 }
 
-type GetProyectoPorColaboradorRequest struct {
+func (x *GetTicketPorDuenoRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// Se busca por owner_id si se indica, y si no por el nombre del dueño. Los
+// tickets se ordenan por número; ticket_numero_min y ticket_numero_max limitan
+// el rango (inclusive) y 0 es sin límite.
+type ListTicketsByOwnerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Colaborador string `protobuf:"bytes,1,opt,name=colaborador,proto3" json:"colaborador,omitempty"`
+	OwnerId         string `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Dueno           string `protobuf:"bytes,2,opt,name=dueno,proto3" json:"dueno,omitempty"`
+	TicketNumeroMin int32  `protobuf:"varint,3,opt,name=ticket_numero_min,json=ticketNumeroMin,proto3" json:"ticket_numero_min,omitempty"`
+	TicketNumeroMax int32  `protobuf:"varint,4,opt,name=ticket_numero_max,json=ticketNumeroMax,proto3" json:"ticket_numero_max,omitempty"`
+	PageSize        int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *GetProyectoPorColaboradorRequest) Reset() {
-	*x = GetProyectoPorColaboradorRequest{}
-	mi := &file_proto_service_proto_msgTypes[22]
+func (x *ListTicketsByOwnerRequest) Reset() {
+	*x = ListTicketsByOwnerRequest{}
+	mi := &file_proto_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProyectoPorColaboradorRequest) String() string {
+func (x *ListTicketsByOwnerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProyectoPorColaboradorRequest) ProtoMessage() {}
+func (*ListTicketsByOwnerRequest) ProtoMessage() {}
 
-func (x *GetProyectoPorColaboradorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[22]
+func (x *ListTicketsByOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,45 +1935,78 @@ func (x *GetProyectoPorColaboradorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProyectoPorColaboradorRequest.ProtoReflect.Descriptor instead.
-func (*GetProyectoPorColaboradorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListTicketsByOwnerRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetProyectoPorColaboradorRequest) GetColaborador() string {
+func (x *ListTicketsByOwnerRequest) GetOwnerId() string {
 	if x != nil {
-		return x.Colaborador
+		return x.OwnerId
 	}
 	return ""
 }
 
-type Persona struct {
+func (x *ListTicketsByOwnerRequest) GetDueno() string {
+	if x != nil {
+		return x.Dueno
+	}
+	return ""
+}
+
+func (x *ListTicketsByOwnerRequest) GetTicketNumeroMin() int32 {
+	if x != nil {
+		return x.TicketNumeroMin
+	}
+	return 0
+}
+
+func (x *ListTicketsByOwnerRequest) GetTicketNumeroMax() int32 {
+	if x != nil {
+		return x.TicketNumeroMax
+	}
+	return 0
+}
+
+func (x *ListTicketsByOwnerRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTicketsByOwnerRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Se busca por colaborador_id si se indica, y si no por el nombre
+type GetProyectoPorColaboradorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID de la persona
-	Nombre   string  `protobuf:"bytes,2,opt,name=nombre,proto3" json:"nombre,omitempty"`
-	Edad     int32   `protobuf:"varint,3,opt,name=edad,proto3" json:"edad,omitempty"`
-	Tickets  []int32 `protobuf:"varint,4,rep,packed,name=tickets,proto3" json:"tickets,omitempty"` // Lista de tickets
-	Proyecto string  `protobuf:"bytes,5,opt,name=proyecto,proto3" json:"proyecto,omitempty"`       // Lista de proyectos
+	Colaborador   string `protobuf:"bytes,1,opt,name=colaborador,proto3" json:"colaborador,omitempty"`
+	ColaboradorId string `protobuf:"bytes,2,opt,name=colaborador_id,json=colaboradorId,proto3" json:"colaborador_id,omitempty"`
 }
 
-func (x *Persona) Reset() {
-	*x = Persona{}
-	mi := &file_proto_service_proto_msgTypes[23]
+func (x *GetProyectoPorColaboradorRequest) Reset() {
+	*x = GetProyectoPorColaboradorRequest{}
+	mi := &file_proto_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Persona) String() string {
+func (x *GetProyectoPorColaboradorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Persona) ProtoMessage() {}
+func (*GetProyectoPorColaboradorRequest) ProtoMessage() {}
 
-func (x *Persona) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[23]
+func (x *GetProyectoPorColaboradorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,19 +2017,77 @@ func (x *Persona) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Persona.ProtoReflect.Descriptor instead.
-func (*Persona) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetProyectoPorColaboradorRequest.ProtoReflect.Descriptor instead.
+func (*GetProyectoPorColaboradorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Persona) GetId() string {
+func (x *GetProyectoPorColaboradorRequest) GetColaborador() string {
 	if x != nil {
-		return x.Id
+		return x.Colaborador
 	}
 	return ""
 }
 
-func (x *Persona) GetNombre() string {
+func (x *GetProyectoPorColaboradorRequest) GetColaboradorId() string {
+	if x != nil {
+		return x.ColaboradorId
+	}
+	return ""
+}
+
+type Persona struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID de la persona
+	Nombre     string  `protobuf:"bytes,2,opt,name=nombre,proto3" json:"nombre,omitempty"`
+	Edad       int32   `protobuf:"varint,3,opt,name=edad,proto3" json:"edad,omitempty"`
+	Tickets    []int32 `protobuf:"varint,4,rep,packed,name=tickets,proto3" json:"tickets,omitempty"`                 // Lista de tickets
+	Proyecto   string  `protobuf:"bytes,5,opt,name=proyecto,proto3" json:"proyecto,omitempty"`                       // Nombre del proyecto, resuelto a partir de proyecto_id
+	Version    int64   `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`                        // Aumenta en cada actualización
+	ProyectoId string  `protobuf:"bytes,7,opt,name=proyecto_id,json=proyectoId,proto3" json:"proyecto_id,omitempty"` // ID del proyecto
+}
+
+func (x *Persona) Reset() {
+	*x = Persona{}
+	mi := &file_proto_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Persona) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Persona) ProtoMessage() {}
+
+func (x *Persona) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Persona.ProtoReflect.Descriptor instead.
+func (*Persona) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Persona) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Persona) GetNombre() string {
 	if x != nil {
 		return x.Nombre
 	}
@@ -1239,19 +2115,42 @@ func (x *Persona) GetProyecto() string {
 	return ""
 }
 
+func (x *Persona) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Persona) GetProyectoId() string {
+	if x != nil {
+		return x.ProyectoId
+	}
+	return ""
+}
+
 type Ticket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                          // ID del ticket
-	TicketNumero int32  `protobuf:"varint,2,opt,name=ticket_numero,json=ticketNumero,proto3" json:"ticket_numero,omitempty"` // Número de ticket
-	Owner        string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`                                    // Propietario del ticket
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                          // ID del ticket
+	TicketNumero int32                  `protobuf:"varint,2,opt,name=ticket_numero,json=ticketNumero,proto3" json:"ticket_numero,omitempty"` // Número de ticket
+	Owner        string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`                                    // Nombre del propietario, resuelto a partir de owner_id
+	Version      int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                               // Aumenta en cada actualización
+	Huerfano     bool                   `protobuf:"varint,5,opt,name=huerfano,proto3" json:"huerfano,omitempty"`                             // Se eliminó su dueño y el ticket no se reasignó
+	OwnerId      string                 `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                 // ID de la persona propietaria del ticket
+	Status       TicketStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=pb.TicketStatus" json:"status,omitempty"`
+	Priority     TicketPriority         `protobuf:"varint,8,opt,name=priority,proto3,enum=pb.TicketPriority" json:"priority,omitempty"`
+	Title        string                 `protobuf:"bytes,9,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Vacío en los tickets anteriores a los timestamps
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Última modificación del ticket
 }
 
 func (x *Ticket) Reset() {
 	*x = Ticket{}
-	mi := &file_proto_service_proto_msgTypes[24]
+	mi := &file_proto_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1263,7 +2162,7 @@ func (x *Ticket) String() string {
 func (*Ticket) ProtoMessage() {}
 
 func (x *Ticket) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[24]
+	mi := &file_proto_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,7 +2175,7 @@ func (x *Ticket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
 func (*Ticket) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{24}
+	return file_proto_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Ticket) GetId() string {
@@ -1300,20 +2199,87 @@ func (x *Ticket) GetOwner() string {
 	return ""
 }
 
+func (x *Ticket) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Ticket) GetHuerfano() bool {
+	if x != nil {
+		return x.Huerfano
+	}
+	return false
+}
+
+func (x *Ticket) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Ticket) GetStatus() TicketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TicketStatus_TICKET_STATUS_UNSPECIFIED
+}
+
+func (x *Ticket) GetPriority() TicketPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TicketPriority_TICKET_PRIORITY_UNSPECIFIED
+}
+
+func (x *Ticket) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Ticket) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Ticket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 type Proyecto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // ID del proyecto
-	Nombre          string   `protobuf:"bytes,2,opt,name=nombre,proto3" json:"nombre,omitempty"`                                          // Nombre del proyecto
-	Colaboradores   []string `protobuf:"bytes,3,rep,name=colaboradores,proto3" json:"colaboradores,omitempty"`                            // Colaboradores en el proyecto
-	NivelDificultad string   `protobuf:"bytes,4,opt,name=nivel_dificultad,json=nivelDificultad,proto3" json:"nivel_dificultad,omitempty"` // Nivel de dificultad del proyecto
+	Id            string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // ID del proyecto
+	Nombre        string   `protobuf:"bytes,2,opt,name=nombre,proto3" json:"nombre,omitempty"`               // Nombre del proyecto
+	Colaboradores []string `protobuf:"bytes,3,rep,name=colaboradores,proto3" json:"colaboradores,omitempty"` // Nombres de los colaboradores, en el orden de colaborador_ids
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	NivelDificultad string     `protobuf:"bytes,4,opt,name=nivel_dificultad,json=nivelDificultad,proto3" json:"nivel_dificultad,omitempty"` // Usar dificultad. Nombre del nivel: fácil, medio o difícil
+	Version         int64      `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`                                       // Aumenta en cada actualización
+	ColaboradorIds  []string   `protobuf:"bytes,6,rep,name=colaborador_ids,json=colaboradorIds,proto3" json:"colaborador_ids,omitempty"`    // IDs de las personas que colaboran en el proyecto
+	Dificultad      Dificultad `protobuf:"varint,7,opt,name=dificultad,proto3,enum=pb.Dificultad" json:"dificultad,omitempty"`              // Nivel de dificultad del proyecto
 }
 
 func (x *Proyecto) Reset() {
 	*x = Proyecto{}
-	mi := &file_proto_service_proto_msgTypes[25]
+	mi := &file_proto_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1325,7 +2291,7 @@ func (x *Proyecto) String() string {
 func (*Proyecto) ProtoMessage() {}
 
 func (x *Proyecto) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[25]
+	mi := &file_proto_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1338,7 +2304,7 @@ func (x *Proyecto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Proyecto.ProtoReflect.Descriptor instead.
 func (*Proyecto) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{25}
+	return file_proto_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Proyecto) GetId() string {
@@ -1362,6 +2328,7 @@ func (x *Proyecto) GetColaboradores() []string {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/service.proto.
 func (x *Proyecto) GetNivelDificultad() string {
 	if x != nil {
 		return x.NivelDificultad
@@ -1369,17 +2336,39 @@ func (x *Proyecto) GetNivelDificultad() string {
 	return ""
 }
 
+func (x *Proyecto) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Proyecto) GetColaboradorIds() []string {
+	if x != nil {
+		return x.ColaboradorIds
+	}
+	return nil
+}
+
+func (x *Proyecto) GetDificultad() Dificultad {
+	if x != nil {
+		return x.Dificultad
+	}
+	return Dificultad_DIFICULTAD_UNSPECIFIED
+}
+
 type GetPersonasResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Personas []*Persona `protobuf:"bytes,1,rep,name=personas,proto3" json:"personas,omitempty"`
+	Personas      []*Persona `protobuf:"bytes,1,rep,name=personas,proto3" json:"personas,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Vacío cuando no hay más páginas
 }
 
 func (x *GetPersonasResponse) Reset() {
 	*x = GetPersonasResponse{}
-	mi := &file_proto_service_proto_msgTypes[26]
+	mi := &file_proto_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1391,7 +2380,7 @@ func (x *GetPersonasResponse) String() string {
 func (*GetPersonasResponse) ProtoMessage() {}
 
 func (x *GetPersonasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[26]
+	mi := &file_proto_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,7 +2393,7 @@ func (x *GetPersonasResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPersonasResponse.ProtoReflect.Descriptor instead.
 func (*GetPersonasResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{26}
+	return file_proto_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetPersonasResponse) GetPersonas() []*Persona {
@@ -1414,17 +2403,25 @@ func (x *GetPersonasResponse) GetPersonas() []*Persona {
 	return nil
 }
 
+func (x *GetPersonasResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetTicketsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tickets []*Ticket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	Tickets       []*Ticket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Vacío cuando no hay más páginas
 }
 
 func (x *GetTicketsResponse) Reset() {
 	*x = GetTicketsResponse{}
-	mi := &file_proto_service_proto_msgTypes[27]
+	mi := &file_proto_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1436,7 +2433,7 @@ func (x *GetTicketsResponse) String() string {
 func (*GetTicketsResponse) ProtoMessage() {}
 
 func (x *GetTicketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[27]
+	mi := &file_proto_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1449,7 +2446,7 @@ func (x *GetTicketsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTicketsResponse.ProtoReflect.Descriptor instead.
 func (*GetTicketsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{27}
+	return file_proto_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetTicketsResponse) GetTickets() []*Ticket {
@@ -1459,17 +2456,26 @@ func (x *GetTicketsResponse) GetTickets() []*Ticket {
 	return nil
 }
 
+func (x *GetTicketsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetProyectosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Proyectos []*Proyecto `protobuf:"bytes,1,rep,name=proyectos,proto3" json:"proyectos,omitempty"`
+	Proyectos       []*Proyecto       `protobuf:"bytes,1,rep,name=proyectos,proto3" json:"proyectos,omitempty"`
+	NextPageToken   string            `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`     // Vacío cuando no hay más páginas
+	PartialFailures []*PartialFailure `protobuf:"bytes,3,rep,name=partial_failures,json=partialFailures,proto3" json:"partial_failures,omitempty"` // Proyectos de la página que se omitieron
 }
 
 func (x *GetProyectosResponse) Reset() {
 	*x = GetProyectosResponse{}
-	mi := &file_proto_service_proto_msgTypes[28]
+	mi := &file_proto_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1481,7 +2487,7 @@ func (x *GetProyectosResponse) String() string {
 func (*GetProyectosResponse) ProtoMessage() {}
 
 func (x *GetProyectosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[28]
+	mi := &file_proto_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1494,7 +2500,7 @@ func (x *GetProyectosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProyectosResponse.ProtoReflect.Descriptor instead.
 func (*GetProyectosResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{28}
+	return file_proto_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetProyectosResponse) GetProyectos() []*Proyecto {
@@ -1504,6 +2510,82 @@ func (x *GetProyectosResponse) GetProyectos() []*Proyecto {
 	return nil
 }
 
+func (x *GetProyectosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetProyectosResponse) GetPartialFailures() []*PartialFailure {
+	if x != nil {
+		return x.PartialFailures
+	}
+	return nil
+}
+
+// Documento que no se pudo leer y se omitió de la respuesta
+type PartialFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                 // ID del documento
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`         // Error al decodificarlo
+	Collection string `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"` // Colección del documento
+}
+
+func (x *PartialFailure) Reset() {
+	*x = PartialFailure{}
+	mi := &file_proto_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartialFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartialFailure) ProtoMessage() {}
+
+func (x *PartialFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartialFailure.ProtoReflect.Descriptor instead.
+func (*PartialFailure) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PartialFailure) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PartialFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PartialFailure) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
 type PersonaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1514,7 +2596,7 @@ type PersonaResponse struct {
 
 func (x *PersonaResponse) Reset() {
 	*x = PersonaResponse{}
-	mi := &file_proto_service_proto_msgTypes[29]
+	mi := &file_proto_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1526,7 +2608,7 @@ func (x *PersonaResponse) String() string {
 func (*PersonaResponse) ProtoMessage() {}
 
 func (x *PersonaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[29]
+	mi := &file_proto_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,7 +2621,7 @@ func (x *PersonaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PersonaResponse.ProtoReflect.Descriptor instead.
 func (*PersonaResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{29}
+	return file_proto_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *PersonaResponse) GetPersona() *Persona {
@@ -1559,7 +2641,7 @@ type TicketResponse struct {
 
 func (x *TicketResponse) Reset() {
 	*x = TicketResponse{}
-	mi := &file_proto_service_proto_msgTypes[30]
+	mi := &file_proto_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1571,7 +2653,7 @@ func (x *TicketResponse) String() string {
 func (*TicketResponse) ProtoMessage() {}
 
 func (x *TicketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[30]
+	mi := &file_proto_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,7 +2666,7 @@ func (x *TicketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TicketResponse.ProtoReflect.Descriptor instead.
 func (*TicketResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{30}
+	return file_proto_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *TicketResponse) GetTicket() *Ticket {
@@ -1604,7 +2686,7 @@ type ProyectoResponse struct {
 
 func (x *ProyectoResponse) Reset() {
 	*x = ProyectoResponse{}
-	mi := &file_proto_service_proto_msgTypes[31]
+	mi := &file_proto_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1616,7 +2698,7 @@ func (x *ProyectoResponse) String() string {
 func (*ProyectoResponse) ProtoMessage() {}
 
 func (x *ProyectoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[31]
+	mi := &file_proto_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1629,7 +2711,7 @@ func (x *ProyectoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProyectoResponse.ProtoReflect.Descriptor instead.
 func (*ProyectoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{31}
+	return file_proto_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ProyectoResponse) GetProyecto() *Proyecto {
@@ -1650,7 +2732,7 @@ type GetColaboradoresPorProyectoRequest struct {
 
 func (x *GetColaboradoresPorProyectoRequest) Reset() {
 	*x = GetColaboradoresPorProyectoRequest{}
-	mi := &file_proto_service_proto_msgTypes[32]
+	mi := &file_proto_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1662,7 +2744,7 @@ func (x *GetColaboradoresPorProyectoRequest) String() string {
 func (*GetColaboradoresPorProyectoRequest) ProtoMessage() {}
 
 func (x *GetColaboradoresPorProyectoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[32]
+	mi := &file_proto_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,7 +2757,7 @@ func (x *GetColaboradoresPorProyectoRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetColaboradoresPorProyectoRequest.ProtoReflect.Descriptor instead.
 func (*GetColaboradoresPorProyectoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{32}
+	return file_proto_service_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetColaboradoresPorProyectoRequest) GetNombreProyecto() string {
@@ -1696,7 +2778,7 @@ type GetColaboradoresPorProyectoResponse struct {
 
 func (x *GetColaboradoresPorProyectoResponse) Reset() {
 	*x = GetColaboradoresPorProyectoResponse{}
-	mi := &file_proto_service_proto_msgTypes[33]
+	mi := &file_proto_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1708,7 +2790,7 @@ func (x *GetColaboradoresPorProyectoResponse) String() string {
 func (*GetColaboradoresPorProyectoResponse) ProtoMessage() {}
 
 func (x *GetColaboradoresPorProyectoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[33]
+	mi := &file_proto_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1721,7 +2803,7 @@ func (x *GetColaboradoresPorProyectoResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetColaboradoresPorProyectoResponse.ProtoReflect.Descriptor instead.
 func (*GetColaboradoresPorProyectoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{33}
+	return file_proto_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetColaboradoresPorProyectoResponse) GetColaboradores() []string {
@@ -1731,256 +2813,2071 @@ func (x *GetColaboradoresPorProyectoResponse) GetColaboradores() []string {
 	return nil
 }
 
-var File_proto_service_proto protoreflect.FileDescriptor
+// Asigna el ticket a la persona: cambia el owner del ticket, lo agrega a los
+// tickets de la persona y lo quita de los del dueño anterior
+type AssignTicketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_service_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x78, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x64, 0x61, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x64, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
-	0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x14, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x64,
-	0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x64, 0x61, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52,
-	0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x79,
-	0x65, 0x63, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x79,
-	0x65, 0x63, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0x50, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x13,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75,
-	0x6d, 0x65, 0x72, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x25,
-	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62,
-	0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a,
-	0x10, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x5f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x44, 0x69,
-	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
-	0x6d, 0x62, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
-	0x64, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c,
-	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x69,
-	0x76, 0x65, 0x6c, 0x5f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x44, 0x69, 0x66, 0x69, 0x63,
-	0x75, 0x6c, 0x74, 0x61, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x5e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x42,
-	0x79, 0x41, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x64, 0x61, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x64, 0x61, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61,
-	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x64, 0x61, 0x64, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x64, 0x61, 0x64, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x61,
-	0x22, 0x40, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x72,
-	0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65,
-	0x72, 0x6f, 0x22, 0x4a, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x73, 0x50, 0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x44, 0x65, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x22, 0x33,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x42, 0x79, 0x4e, 0x6f,
-	0x6d, 0x62, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e,
-	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6d,
-	0x62, 0x72, 0x65, 0x22, 0x30, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x50, 0x6f, 0x72, 0x44, 0x75, 0x65, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x75, 0x65, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x64, 0x75, 0x65, 0x6e, 0x6f, 0x22, 0x44, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79,
-	0x65, 0x63, 0x74, 0x6f, 0x50, 0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64,
-	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c,
-	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x22, 0x7b, 0x0a, 0x07, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x65, 0x64, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x64,
-	0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
-	0x65, 0x72, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x83, 0x01,
-	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f,
-	0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6d, 0x62,
-	0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
-	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62,
-	0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x69, 0x76, 0x65,
-	0x6c, 0x5f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x44, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c,
-	0x74, 0x61, 0x64, 0x22, 0x3e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x08, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22,
-	0x42, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x79, 0x65,
-	0x63, 0x74, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63,
-	0x74, 0x6f, 0x73, 0x22, 0x38, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x22, 0x34, 0x0a,
-	0x0e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x22, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x79, 0x65,
-	0x63, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74,
-	0x6f, 0x22, 0x4d, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
-	0x64, 0x6f, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x6f, 0x6d, 0x62, 0x72,
-	0x65, 0x5f, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
-	0x22, 0x4b, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64,
-	0x6f, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62,
-	0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x32, 0xaa, 0x06,
-	0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
-	0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63,
-	0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73,
-	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x52, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73,
-	0x42, 0x79, 0x41, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x42, 0x79, 0x41, 0x67, 0x65,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x61, 0x73, 0x50, 0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x44, 0x65, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x50, 0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x44,
-	0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x42, 0x79, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x1d, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x42, 0x79, 0x4e,
-	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f,
-	0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x44, 0x75, 0x65, 0x6e, 0x6f, 0x12,
-	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f,
-	0x72, 0x44, 0x75, 0x65, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x57, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
-	0x50, 0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x24,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x50,
-	0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63,
-	0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x1b, 0x47, 0x65,
+	TicketNumero int32 `protobuf:"varint,1,opt,name=ticket_numero,json=ticketNumero,proto3" json:"ticket_numero,omitempty"`
+	// Deprecated: Marked as deprecated in proto/service.proto.
+	Persona   string `protobuf:"bytes,2,opt,name=persona,proto3" json:"persona,omitempty"`                      // Usar persona_id
+	PersonaId string `protobuf:"bytes,3,opt,name=persona_id,json=personaId,proto3" json:"persona_id,omitempty"` // ID de la persona que recibe el ticket
+}
+
+func (x *AssignTicketRequest) Reset() {
+	*x = AssignTicketRequest{}
+	mi := &file_proto_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTicketRequest) ProtoMessage() {}
+
+func (x *AssignTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTicketRequest.ProtoReflect.Descriptor instead.
+func (*AssignTicketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AssignTicketRequest) GetTicketNumero() int32 {
+	if x != nil {
+		return x.TicketNumero
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/service.proto.
+func (x *AssignTicketRequest) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+func (x *AssignTicketRequest) GetPersonaId() string {
+	if x != nil {
+		return x.PersonaId
+	}
+	return ""
+}
+
+type AssignTicketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket  *Ticket  `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Persona *Persona `protobuf:"bytes,2,opt,name=persona,proto3" json:"persona,omitempty"`
+}
+
+func (x *AssignTicketResponse) Reset() {
+	*x = AssignTicketResponse{}
+	mi := &file_proto_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTicketResponse) ProtoMessage() {}
+
+func (x *AssignTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTicketResponse.ProtoReflect.Descriptor instead.
+func (*AssignTicketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AssignTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *AssignTicketResponse) GetPersona() *Persona {
+	if x != nil {
+		return x.Persona
+	}
+	return nil
+}
+
+// Deja el ticket sin dueño y lo quita de los tickets de todas las personas
+type UnassignTicketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TicketNumero int32 `protobuf:"varint,1,opt,name=ticket_numero,json=ticketNumero,proto3" json:"ticket_numero,omitempty"`
+}
+
+func (x *UnassignTicketRequest) Reset() {
+	*x = UnassignTicketRequest{}
+	mi := &file_proto_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignTicketRequest) ProtoMessage() {}
+
+func (x *UnassignTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignTicketRequest.ProtoReflect.Descriptor instead.
+func (*UnassignTicketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnassignTicketRequest) GetTicketNumero() int32 {
+	if x != nil {
+		return x.TicketNumero
+	}
+	return 0
+}
+
+type UnassignTicketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket   *Ticket `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Personas int32   `protobuf:"varint,2,opt,name=personas,proto3" json:"personas,omitempty"` // Cantidad de personas que tenían el ticket
+}
+
+func (x *UnassignTicketResponse) Reset() {
+	*x = UnassignTicketResponse{}
+	mi := &file_proto_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignTicketResponse) ProtoMessage() {}
+
+func (x *UnassignTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignTicketResponse.ProtoReflect.Descriptor instead.
+func (*UnassignTicketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UnassignTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *UnassignTicketResponse) GetPersonas() int32 {
+	if x != nil {
+		return x.Personas
+	}
+	return 0
+}
+
+// Cambia el nombre de la persona y actualiza la copia del nombre en el owner
+// de sus tickets y en los colaboradores de los proyectos
+type RenamePersonaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version     int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Versión actual de la persona
+	NuevoNombre string `protobuf:"bytes,3,opt,name=nuevo_nombre,json=nuevoNombre,proto3" json:"nuevo_nombre,omitempty"`
+}
+
+func (x *RenamePersonaRequest) Reset() {
+	*x = RenamePersonaRequest{}
+	mi := &file_proto_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenamePersonaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenamePersonaRequest) ProtoMessage() {}
+
+func (x *RenamePersonaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenamePersonaRequest.ProtoReflect.Descriptor instead.
+func (*RenamePersonaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RenamePersonaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenamePersonaRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RenamePersonaRequest) GetNuevoNombre() string {
+	if x != nil {
+		return x.NuevoNombre
+	}
+	return ""
+}
+
+type RenamePersonaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Persona *Persona `protobuf:"bytes,1,opt,name=persona,proto3" json:"persona,omitempty"`
+	// Cantidad de documentos que se actualizaron con el nombre nuevo
+	Tickets   int32 `protobuf:"varint,2,opt,name=tickets,proto3" json:"tickets,omitempty"`
+	Proyectos int32 `protobuf:"varint,3,opt,name=proyectos,proto3" json:"proyectos,omitempty"`
+}
+
+func (x *RenamePersonaResponse) Reset() {
+	*x = RenamePersonaResponse{}
+	mi := &file_proto_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenamePersonaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenamePersonaResponse) ProtoMessage() {}
+
+func (x *RenamePersonaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenamePersonaResponse.ProtoReflect.Descriptor instead.
+func (*RenamePersonaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RenamePersonaResponse) GetPersona() *Persona {
+	if x != nil {
+		return x.Persona
+	}
+	return nil
+}
+
+func (x *RenamePersonaResponse) GetTickets() int32 {
+	if x != nil {
+		return x.Tickets
+	}
+	return 0
+}
+
+func (x *RenamePersonaResponse) GetProyectos() int32 {
+	if x != nil {
+		return x.Proyectos
+	}
+	return 0
+}
+
+// Cambia el estado del ticket a status si la configuración permite pasar del
+// estado actual a ese. version debe ser la versión actual del ticket, que es
+// 0 en los tickets creados antes de las versiones.
+type TransitionTicketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  TicketStatus `protobuf:"varint,2,opt,name=status,proto3,enum=pb.TicketStatus" json:"status,omitempty"`
+	Version int64        `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *TransitionTicketRequest) Reset() {
+	*x = TransitionTicketRequest{}
+	mi := &file_proto_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionTicketRequest) ProtoMessage() {}
+
+func (x *TransitionTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionTicketRequest.ProtoReflect.Descriptor instead.
+func (*TransitionTicketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TransitionTicketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransitionTicketRequest) GetStatus() TicketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TicketStatus_TICKET_STATUS_UNSPECIFIED
+}
+
+func (x *TransitionTicketRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type TransitionTicketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket         *Ticket      `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	PreviousStatus TicketStatus `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=pb.TicketStatus" json:"previous_status,omitempty"`
+}
+
+func (x *TransitionTicketResponse) Reset() {
+	*x = TransitionTicketResponse{}
+	mi := &file_proto_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionTicketResponse) ProtoMessage() {}
+
+func (x *TransitionTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionTicketResponse.ProtoReflect.Descriptor instead.
+func (*TransitionTicketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TransitionTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *TransitionTicketResponse) GetPreviousStatus() TicketStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return TicketStatus_TICKET_STATUS_UNSPECIFIED
+}
+
+// Agrega un comentario al ticket. Si author está vacío se usa el actor de la
+// metadata actor.
+type AddTicketCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TicketId string `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Author   string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Body     string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *AddTicketCommentRequest) Reset() {
+	*x = AddTicketCommentRequest{}
+	mi := &file_proto_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTicketCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTicketCommentRequest) ProtoMessage() {}
+
+func (x *AddTicketCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTicketCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddTicketCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddTicketCommentRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *AddTicketCommentRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *AddTicketCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type TicketComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId  string                 `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Author    string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Body      string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *TicketComment) Reset() {
+	*x = TicketComment{}
+	mi := &file_proto_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketComment) ProtoMessage() {}
+
+func (x *TicketComment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketComment.ProtoReflect.Descriptor instead.
+func (*TicketComment) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TicketComment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketComment) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *TicketComment) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *TicketComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TicketComment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Los comentarios se devuelven en el orden en que se agregaron
+type ListTicketCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TicketId  string `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListTicketCommentsRequest) Reset() {
+	*x = ListTicketCommentsRequest{}
+	mi := &file_proto_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketCommentsRequest) ProtoMessage() {}
+
+func (x *ListTicketCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListTicketCommentsRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *ListTicketCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTicketCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTicketCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments      []*TicketComment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListTicketCommentsResponse) Reset() {
+	*x = ListTicketCommentsResponse{}
+	mi := &file_proto_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketCommentsResponse) ProtoMessage() {}
+
+func (x *ListTicketCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListTicketCommentsResponse) GetComments() []*TicketComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListTicketCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Los eventos se devuelven en el orden en que ocurrieron. El historial de un
+// ticket eliminado se conserva.
+type GetTicketHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TicketId  string `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetTicketHistoryRequest) Reset() {
+	*x = GetTicketHistoryRequest{}
+	mi := &file_proto_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketHistoryRequest) ProtoMessage() {}
+
+func (x *GetTicketHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetTicketHistoryRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *GetTicketHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTicketHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetTicketHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events        []*TicketEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetTicketHistoryResponse) Reset() {
+	*x = GetTicketHistoryResponse{}
+	mi := &file_proto_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketHistoryResponse) ProtoMessage() {}
+
+func (x *GetTicketHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTicketHistoryResponse) GetEvents() []*TicketEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetTicketHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Cambio de un ticket registrado en el historial
+type TicketEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId     string                 `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	TicketNumero int32                  `protobuf:"varint,3,opt,name=ticket_numero,json=ticketNumero,proto3" json:"ticket_numero,omitempty"`
+	Type         TicketEventType        `protobuf:"varint,4,opt,name=type,proto3,enum=pb.TicketEventType" json:"type,omitempty"`
+	Actor        string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"` // Valor de la metadata actor de la solicitud
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Changes      []*FieldChange         `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *TicketEvent) Reset() {
+	*x = TicketEvent{}
+	mi := &file_proto_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketEvent) ProtoMessage() {}
+
+func (x *TicketEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketEvent.ProtoReflect.Descriptor instead.
+func (*TicketEvent) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TicketEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetTicketNumero() int32 {
+	if x != nil {
+		return x.TicketNumero
+	}
+	return 0
+}
+
+func (x *TicketEvent) GetType() TicketEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TicketEventType_TICKET_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *TicketEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TicketEvent) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Valor de un campo antes y después del cambio. Los enums se informan con el
+// nombre del valor.
+type FieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type CheckIntegrityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckIntegrityRequest) Reset() {
+	*x = CheckIntegrityRequest{}
+	mi := &file_proto_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckIntegrityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIntegrityRequest) ProtoMessage() {}
+
+func (x *CheckIntegrityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIntegrityRequest.ProtoReflect.Descriptor instead.
+func (*CheckIntegrityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{55}
+}
+
+// Referencia desde un documento a otro que no existe
+type DanglingReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"` // Colección del documento con la referencia
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                 // ID del documento con la referencia
+	Field      string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`           // Campo que contiene la referencia
+	Value      string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`           // Valor referenciado que no existe
+}
+
+func (x *DanglingReference) Reset() {
+	*x = DanglingReference{}
+	mi := &file_proto_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DanglingReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DanglingReference) ProtoMessage() {}
+
+func (x *DanglingReference) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DanglingReference.ProtoReflect.Descriptor instead.
+func (*DanglingReference) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DanglingReference) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *DanglingReference) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DanglingReference) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *DanglingReference) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CheckIntegrityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dangling []*DanglingReference `protobuf:"bytes,1,rep,name=dangling,proto3" json:"dangling,omitempty"`
+	// Cantidad de documentos revisados de cada colección
+	Personas  int32 `protobuf:"varint,2,opt,name=personas,proto3" json:"personas,omitempty"`
+	Tickets   int32 `protobuf:"varint,3,opt,name=tickets,proto3" json:"tickets,omitempty"`
+	Proyectos int32 `protobuf:"varint,4,opt,name=proyectos,proto3" json:"proyectos,omitempty"`
+	// Documentos que no se pudieron leer; sus referencias no se verificaron
+	PartialFailures []*PartialFailure `protobuf:"bytes,5,rep,name=partial_failures,json=partialFailures,proto3" json:"partial_failures,omitempty"`
+}
+
+func (x *CheckIntegrityResponse) Reset() {
+	*x = CheckIntegrityResponse{}
+	mi := &file_proto_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckIntegrityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIntegrityResponse) ProtoMessage() {}
+
+func (x *CheckIntegrityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIntegrityResponse.ProtoReflect.Descriptor instead.
+func (*CheckIntegrityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CheckIntegrityResponse) GetDangling() []*DanglingReference {
+	if x != nil {
+		return x.Dangling
+	}
+	return nil
+}
+
+func (x *CheckIntegrityResponse) GetPersonas() int32 {
+	if x != nil {
+		return x.Personas
+	}
+	return 0
+}
+
+func (x *CheckIntegrityResponse) GetTickets() int32 {
+	if x != nil {
+		return x.Tickets
+	}
+	return 0
+}
+
+func (x *CheckIntegrityResponse) GetProyectos() int32 {
+	if x != nil {
+		return x.Proyectos
+	}
+	return 0
+}
+
+func (x *CheckIntegrityResponse) GetPartialFailures() []*PartialFailure {
+	if x != nil {
+		return x.PartialFailures
+	}
+	return nil
+}
+
+// Con dry_run solo informa los cambios, sin aplicarlos
+type ReconcileTicketOwnersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ReconcileTicketOwnersRequest) Reset() {
+	*x = ReconcileTicketOwnersRequest{}
+	mi := &file_proto_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileTicketOwnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileTicketOwnersRequest) ProtoMessage() {}
+
+func (x *ReconcileTicketOwnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileTicketOwnersRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileTicketOwnersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReconcileTicketOwnersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Tickets que se agregan y se quitan de la lista de una persona para que
+// coincida con el dueño de cada ticket
+type TicketListRepair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonaId string  `protobuf:"bytes,1,opt,name=persona_id,json=personaId,proto3" json:"persona_id,omitempty"`
+	Persona   string  `protobuf:"bytes,2,opt,name=persona,proto3" json:"persona,omitempty"`
+	Added     []int32 `protobuf:"varint,3,rep,packed,name=added,proto3" json:"added,omitempty"`
+	Removed   []int32 `protobuf:"varint,4,rep,packed,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *TicketListRepair) Reset() {
+	*x = TicketListRepair{}
+	mi := &file_proto_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketListRepair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketListRepair) ProtoMessage() {}
+
+func (x *TicketListRepair) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketListRepair.ProtoReflect.Descriptor instead.
+func (*TicketListRepair) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TicketListRepair) GetPersonaId() string {
+	if x != nil {
+		return x.PersonaId
+	}
+	return ""
+}
+
+func (x *TicketListRepair) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+func (x *TicketListRepair) GetAdded() []int32 {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *TicketListRepair) GetRemoved() []int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+type ReconcileTicketOwnersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repairs []*TicketListRepair `protobuf:"bytes,1,rep,name=repairs,proto3" json:"repairs,omitempty"`
+	// Cantidad de documentos revisados de cada colección
+	Personas int32 `protobuf:"varint,2,opt,name=personas,proto3" json:"personas,omitempty"`
+	Tickets  int32 `protobuf:"varint,3,opt,name=tickets,proto3" json:"tickets,omitempty"`
+	// Personas que cambiaron durante la reconciliación y no se repararon; se
+	// corrigen volviendo a ejecutarla
+	Conflicts int32 `protobuf:"varint,4,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// Documentos que no se pudieron leer. Si alguno es un ticket, no se quitan
+	// números de las listas porque podrían ser de ese ticket.
+	PartialFailures []*PartialFailure `protobuf:"bytes,5,rep,name=partial_failures,json=partialFailures,proto3" json:"partial_failures,omitempty"`
+}
+
+func (x *ReconcileTicketOwnersResponse) Reset() {
+	*x = ReconcileTicketOwnersResponse{}
+	mi := &file_proto_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileTicketOwnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileTicketOwnersResponse) ProtoMessage() {}
+
+func (x *ReconcileTicketOwnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileTicketOwnersResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileTicketOwnersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReconcileTicketOwnersResponse) GetRepairs() []*TicketListRepair {
+	if x != nil {
+		return x.Repairs
+	}
+	return nil
+}
+
+func (x *ReconcileTicketOwnersResponse) GetPersonas() int32 {
+	if x != nil {
+		return x.Personas
+	}
+	return 0
+}
+
+func (x *ReconcileTicketOwnersResponse) GetTickets() int32 {
+	if x != nil {
+		return x.Tickets
+	}
+	return 0
+}
+
+func (x *ReconcileTicketOwnersResponse) GetConflicts() int32 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
+}
+
+func (x *ReconcileTicketOwnersResponse) GetPartialFailures() []*PartialFailure {
+	if x != nil {
+		return x.PartialFailures
+	}
+	return nil
+}
+
+var File_proto_service_proto protoreflect.FileDescriptor
+
+var file_proto_service_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xca, 0x01, 0x0a, 0x14, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x64,
+	0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x64, 0x61, 0x64, 0x12, 0x1c,
+	0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x79,
+	0x65, 0x63, 0x74, 0x6f, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x02, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x64,
+	0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x64, 0x61, 0x64, 0x12, 0x1c,
+	0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x3b, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63,
+	0x74, 0x6f, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x13, 0x72, 0x65,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x74,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x11, 0x72, 0x65, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x54, 0x6f, 0x12, 0x33,
+	0x0a, 0x16, 0x72, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x72, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x54,
+	0x6f, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x9c, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d,
+	0x65, 0x72, 0x6f, 0x12, 0x18, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x65, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x22, 0xbe, 0x02, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e,
+	0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x12, 0x18, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x3f, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8a,
+	0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6d, 0x62,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65,
+	0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x69,
+	0x76, 0x65, 0x6c, 0x5f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x44,
+	0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x52,
+	0x0a, 0x64, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xc8, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63,
+	0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6d,
+	0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72,
+	0x65, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x63, 0x6f,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x6e,
+	0x69, 0x76, 0x65, 0x6c, 0x5f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x6e, 0x69, 0x76, 0x65, 0x6c,
+	0x44, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x64, 0x69,
+	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x52, 0x0a,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x22, 0x41, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x16, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x5e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x73, 0x42, 0x79, 0x41, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x64, 0x61, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x64, 0x61, 0x64, 0x4d, 0x69, 0x6e, 0x69,
+	0x6d, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x64, 0x61, 0x64, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x64, 0x61, 0x64, 0x4d, 0x61, 0x78, 0x69,
+	0x6d, 0x61, 0x22, 0x40, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50,
+	0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75,
+	0x6d, 0x65, 0x72, 0x6f, 0x22, 0x4a, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x73, 0x50, 0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x44, 0x65, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f,
+	0x22, 0x33, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x42, 0x79,
+	0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x22, 0x4b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x50, 0x6f, 0x72, 0x44, 0x75, 0x65, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x75, 0x65, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x75, 0x65, 0x6e, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xe0, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x75, 0x65, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x75, 0x65, 0x6e,
+	0x6f, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x6f, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x4d, 0x69, 0x6e, 0x12, 0x2a, 0x0a,
+	0x11, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x5f, 0x6d,
+	0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79,
+	0x65, 0x63, 0x74, 0x6f, 0x50, 0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72,
+	0x49, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x64, 0x61, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x64, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x49, 0x64, 0x22, 0xac, 0x03, 0x0a, 0x06,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x75, 0x65, 0x72, 0x66, 0x61, 0x6e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68,
+	0x75, 0x65, 0x72, 0x66, 0x61, 0x6e, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
+	0x64, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x5f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x0f, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x44, 0x69, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72,
+	0x61, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x52, 0x0a, 0x64, 0x69, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x22, 0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x08, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x08, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x62, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65,
+	0x63, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x3d, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x0f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22,
+	0x58, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x0f, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x22, 0x34, 0x0a, 0x0e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x50, 0x72, 0x6f,
+	0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x12,
+	0x1c, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x14,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x22,
+	0x3c, 0x0a, 0x15, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x22, 0x58, 0x0a,
+	0x16, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x22, 0x63, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x65,
+	0x76, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6e, 0x75, 0x65, 0x76, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x22, 0x76, 0x0a, 0x15,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63,
+	0x74, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x79, 0x65,
+	0x63, 0x74, 0x6f, 0x73, 0x22, 0x6d, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x62,
+	0x0a, 0x17, 0x41, 0x64, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x74, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x73,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x72, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x84, 0x02, 0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x29, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x0b, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x17,
+	0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6f, 0x0a, 0x11, 0x44, 0x61, 0x6e, 0x67, 0x6c,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x6e, 0x67, 0x6c,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x64, 0x61,
+	0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x12, 0x3d, 0x0a, 0x10, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x1c, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0x7b, 0x0a, 0x10, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22,
+	0xe2, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x52, 0x07, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x2a, 0xb5, 0x01, 0x0a, 0x0c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e,
+	0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x54,
+	0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x9c, 0x01, 0x0a,
+	0x0e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x1f, 0x0a, 0x1b, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x49, 0x43,
+	0x4b, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x45, 0x44,
+	0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f,
+	0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03, 0x12,
+	0x1a, 0x0a, 0x16, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x55, 0x52, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x2a, 0x6c, 0x0a, 0x0a, 0x44,
+	0x69, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x46,
+	0x49, 0x43, 0x55, 0x4c, 0x54, 0x41, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x49, 0x46, 0x49, 0x43, 0x55, 0x4c,
+	0x54, 0x41, 0x44, 0x5f, 0x46, 0x41, 0x43, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x44,
+	0x49, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x41, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x4f, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x41, 0x44, 0x5f,
+	0x44, 0x49, 0x46, 0x49, 0x43, 0x49, 0x4c, 0x10, 0x03, 0x2a, 0x9e, 0x01, 0x0a, 0x0f, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a,
+	0x1d, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x23, 0x0a, 0x1f, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x32, 0xb0, 0x08, 0x0a, 0x0f, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x12, 0x17,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x12, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x42, 0x79, 0x41,
+	0x67, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x42, 0x79, 0x41, 0x67, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x73, 0x50, 0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x44, 0x65, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x73, 0x50, 0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x44, 0x65, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x42, 0x79, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x42, 0x79, 0x4e, 0x6f, 0x6d, 0x62,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x4e, 0x75,
+	0x6d, 0x65, 0x72, 0x6f, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x44, 0x75, 0x65, 0x6e, 0x6f, 0x12, 0x1c, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x44, 0x75,
+	0x65, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
+	0x88, 0x02, 0x01, 0x12, 0x4b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x57, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x50,
+	0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x24, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x50, 0x6f,
+	0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
 	0x74, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x50, 0x6f,
-	0x72, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x50,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f,
-	0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63,
-	0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf9, 0x04, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x61, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x17,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79,
-	0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63,
-	0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x43, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65,
-	0x63, 0x74, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x72, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72,
+	0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x30, 0x01, 0x12, 0x3d,
+	0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f,
+	0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f,
+	0x79, 0x65, 0x63, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x30, 0x01, 0x32, 0x82, 0x09,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x44, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x6f, 0x2d, 0x67, 0x72, 0x70,
-	0x63, 0x2d, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74,
+	0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x79, 0x65, 0x63, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x0c, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e,
+	0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x19,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x41, 0x64,
+	0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xb5, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x6f,
+	0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1995,94 +4892,184 @@ func file_proto_service_proto_rawDescGZIP() []byte {
 	return file_proto_service_proto_rawDescData
 }
 
-var file_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_proto_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_proto_service_proto_goTypes = []any{
-	(*CreatePersonaRequest)(nil),                // 0: pb.CreatePersonaRequest
-	(*CreatePersonaResponse)(nil),               // 1: pb.CreatePersonaResponse
-	(*UpdatePersonaRequest)(nil),                // 2: pb.UpdatePersonaRequest
-	(*UpdatePersonaResponse)(nil),               // 3: pb.UpdatePersonaResponse
-	(*DeletePersonaRequest)(nil),                // 4: pb.DeletePersonaRequest
-	(*DeletePersonaResponse)(nil),               // 5: pb.DeletePersonaResponse
-	(*CreateTicketRequest)(nil),                 // 6: pb.CreateTicketRequest
-	(*CreateTicketResponse)(nil),                // 7: pb.CreateTicketResponse
-	(*UpdateTicketRequest)(nil),                 // 8: pb.UpdateTicketRequest
-	(*DeleteTicketRequest)(nil),                 // 9: pb.DeleteTicketRequest
-	(*CreateProyectoRequest)(nil),               // 10: pb.CreateProyectoRequest
-	(*CreateProyectoResponse)(nil),              // 11: pb.CreateProyectoResponse
-	(*UpdateProyectoRequest)(nil),               // 12: pb.UpdateProyectoRequest
-	(*DeleteProyectoRequest)(nil),               // 13: pb.DeleteProyectoRequest
-	(*GetPersonasRequest)(nil),                  // 14: pb.GetPersonasRequest
-	(*GetTicketsRequest)(nil),                   // 15: pb.GetTicketsRequest
-	(*GetProyectosRequest)(nil),                 // 16: pb.GetProyectosRequest
-	(*GetPersonasByAgeRangeRequest)(nil),        // 17: pb.GetPersonasByAgeRangeRequest
-	(*GetTicketPorNumeroRequest)(nil),           // 18: pb.GetTicketPorNumeroRequest
-	(*GetPersonasPorNumeroDeTicketRequest)(nil), // 19: pb.GetPersonasPorNumeroDeTicketRequest
-	(*GetPersonaByNombreRequest)(nil),           // 20: pb.GetPersonaByNombreRequest
-	(*GetTicketPorDuenoRequest)(nil),            // 21: pb.GetTicketPorDuenoRequest
-	(*GetProyectoPorColaboradorRequest)(nil),    // 22: pb.GetProyectoPorColaboradorRequest
-	(*Persona)(nil),                             // 23: pb.Persona
-	(*Ticket)(nil),                              // 24: pb.Ticket
-	(*Proyecto)(nil),                            // 25: pb.Proyecto
-	(*GetPersonasResponse)(nil),                 // 26: pb.GetPersonasResponse
-	(*GetTicketsResponse)(nil),                  // 27: pb.GetTicketsResponse
-	(*GetProyectosResponse)(nil),                // 28: pb.GetProyectosResponse
-	(*PersonaResponse)(nil),                     // 29: pb.PersonaResponse
-	(*TicketResponse)(nil),                      // 30: pb.TicketResponse
-	(*ProyectoResponse)(nil),                    // 31: pb.ProyectoResponse
-	(*GetColaboradoresPorProyectoRequest)(nil),  // 32: pb.GetColaboradoresPorProyectoRequest
-	(*GetColaboradoresPorProyectoResponse)(nil), // 33: pb.GetColaboradoresPorProyectoResponse
-	(*emptypb.Empty)(nil),                       // 34: google.protobuf.Empty
+	(TicketStatus)(0),                           // 0: pb.TicketStatus
+	(TicketPriority)(0),                         // 1: pb.TicketPriority
+	(Dificultad)(0),                             // 2: pb.Dificultad
+	(TicketEventType)(0),                        // 3: pb.TicketEventType
+	(*CreatePersonaRequest)(nil),                // 4: pb.CreatePersonaRequest
+	(*CreatePersonaResponse)(nil),               // 5: pb.CreatePersonaResponse
+	(*UpdatePersonaRequest)(nil),                // 6: pb.UpdatePersonaRequest
+	(*UpdatePersonaResponse)(nil),               // 7: pb.UpdatePersonaResponse
+	(*DeletePersonaRequest)(nil),                // 8: pb.DeletePersonaRequest
+	(*DeletePersonaResponse)(nil),               // 9: pb.DeletePersonaResponse
+	(*CreateTicketRequest)(nil),                 // 10: pb.CreateTicketRequest
+	(*CreateTicketResponse)(nil),                // 11: pb.CreateTicketResponse
+	(*UpdateTicketRequest)(nil),                 // 12: pb.UpdateTicketRequest
+	(*DeleteTicketRequest)(nil),                 // 13: pb.DeleteTicketRequest
+	(*CreateProyectoRequest)(nil),               // 14: pb.CreateProyectoRequest
+	(*CreateProyectoResponse)(nil),              // 15: pb.CreateProyectoResponse
+	(*UpdateProyectoRequest)(nil),               // 16: pb.UpdateProyectoRequest
+	(*DeleteProyectoRequest)(nil),               // 17: pb.DeleteProyectoRequest
+	(*GetPersonasRequest)(nil),                  // 18: pb.GetPersonasRequest
+	(*GetTicketsRequest)(nil),                   // 19: pb.GetTicketsRequest
+	(*GetProyectosRequest)(nil),                 // 20: pb.GetProyectosRequest
+	(*StreamPersonasRequest)(nil),               // 21: pb.StreamPersonasRequest
+	(*StreamTicketsRequest)(nil),                // 22: pb.StreamTicketsRequest
+	(*StreamProyectosRequest)(nil),              // 23: pb.StreamProyectosRequest
+	(*GetPersonasByAgeRangeRequest)(nil),        // 24: pb.GetPersonasByAgeRangeRequest
+	(*GetTicketPorNumeroRequest)(nil),           // 25: pb.GetTicketPorNumeroRequest
+	(*GetPersonasPorNumeroDeTicketRequest)(nil), // 26: pb.GetPersonasPorNumeroDeTicketRequest
+	(*GetPersonaByNombreRequest)(nil),           // 27: pb.GetPersonaByNombreRequest
+	(*GetTicketPorDuenoRequest)(nil),            // 28: pb.GetTicketPorDuenoRequest
+	(*ListTicketsByOwnerRequest)(nil),           // 29: pb.ListTicketsByOwnerRequest
+	(*GetProyectoPorColaboradorRequest)(nil),    // 30: pb.GetProyectoPorColaboradorRequest
+	(*Persona)(nil),                             // 31: pb.Persona
+	(*Ticket)(nil),                              // 32: pb.Ticket
+	(*Proyecto)(nil),                            // 33: pb.Proyecto
+	(*GetPersonasResponse)(nil),                 // 34: pb.GetPersonasResponse
+	(*GetTicketsResponse)(nil),                  // 35: pb.GetTicketsResponse
+	(*GetProyectosResponse)(nil),                // 36: pb.GetProyectosResponse
+	(*PartialFailure)(nil),                      // 37: pb.PartialFailure
+	(*PersonaResponse)(nil),                     // 38: pb.PersonaResponse
+	(*TicketResponse)(nil),                      // 39: pb.TicketResponse
+	(*ProyectoResponse)(nil),                    // 40: pb.ProyectoResponse
+	(*GetColaboradoresPorProyectoRequest)(nil),  // 41: pb.GetColaboradoresPorProyectoRequest
+	(*GetColaboradoresPorProyectoResponse)(nil), // 42: pb.GetColaboradoresPorProyectoResponse
+	(*AssignTicketRequest)(nil),                 // 43: pb.AssignTicketRequest
+	(*AssignTicketResponse)(nil),                // 44: pb.AssignTicketResponse
+	(*UnassignTicketRequest)(nil),               // 45: pb.UnassignTicketRequest
+	(*UnassignTicketResponse)(nil),              // 46: pb.UnassignTicketResponse
+	(*RenamePersonaRequest)(nil),                // 47: pb.RenamePersonaRequest
+	(*RenamePersonaResponse)(nil),               // 48: pb.RenamePersonaResponse
+	(*TransitionTicketRequest)(nil),             // 49: pb.TransitionTicketRequest
+	(*TransitionTicketResponse)(nil),            // 50: pb.TransitionTicketResponse
+	(*AddTicketCommentRequest)(nil),             // 51: pb.AddTicketCommentRequest
+	(*TicketComment)(nil),                       // 52: pb.TicketComment
+	(*ListTicketCommentsRequest)(nil),           // 53: pb.ListTicketCommentsRequest
+	(*ListTicketCommentsResponse)(nil),          // 54: pb.ListTicketCommentsResponse
+	(*GetTicketHistoryRequest)(nil),             // 55: pb.GetTicketHistoryRequest
+	(*GetTicketHistoryResponse)(nil),            // 56: pb.GetTicketHistoryResponse
+	(*TicketEvent)(nil),                         // 57: pb.TicketEvent
+	(*FieldChange)(nil),                         // 58: pb.FieldChange
+	(*CheckIntegrityRequest)(nil),               // 59: pb.CheckIntegrityRequest
+	(*DanglingReference)(nil),                   // 60: pb.DanglingReference
+	(*CheckIntegrityResponse)(nil),              // 61: pb.CheckIntegrityResponse
+	(*ReconcileTicketOwnersRequest)(nil),        // 62: pb.ReconcileTicketOwnersRequest
+	(*TicketListRepair)(nil),                    // 63: pb.TicketListRepair
+	(*ReconcileTicketOwnersResponse)(nil),       // 64: pb.ReconcileTicketOwnersResponse
+	(*fieldmaskpb.FieldMask)(nil),               // 65: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),               // 66: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                       // 67: google.protobuf.Empty
 }
 var file_proto_service_proto_depIdxs = []int32{
-	23, // 0: pb.GetPersonasResponse.personas:type_name -> pb.Persona
-	24, // 1: pb.GetTicketsResponse.tickets:type_name -> pb.Ticket
-	25, // 2: pb.GetProyectosResponse.proyectos:type_name -> pb.Proyecto
-	23, // 3: pb.PersonaResponse.persona:type_name -> pb.Persona
-	24, // 4: pb.TicketResponse.ticket:type_name -> pb.Ticket
-	25, // 5: pb.ProyectoResponse.proyecto:type_name -> pb.Proyecto
-	16, // 6: pb.PersonasService.GetProyectos:input_type -> pb.GetProyectosRequest
-	15, // 7: pb.PersonasService.GetTickets:input_type -> pb.GetTicketsRequest
-	14, // 8: pb.PersonasService.GetPersonas:input_type -> pb.GetPersonasRequest
-	17, // 9: pb.PersonasService.GetPersonasByAgeRange:input_type -> pb.GetPersonasByAgeRangeRequest
-	19, // 10: pb.PersonasService.GetPersonasPorNumeroDeTicket:input_type -> pb.GetPersonasPorNumeroDeTicketRequest
-	20, // 11: pb.PersonasService.GetPersonaByNombre:input_type -> pb.GetPersonaByNombreRequest
-	18, // 12: pb.PersonasService.GetTicketPorNumero:input_type -> pb.GetTicketPorNumeroRequest
-	21, // 13: pb.PersonasService.GetTicketPorDueno:input_type -> pb.GetTicketPorDuenoRequest
-	22, // 14: pb.PersonasService.GetProyectoPorColaborador:input_type -> pb.GetProyectoPorColaboradorRequest
-	32, // 15: pb.PersonasService.GetColaboradoresPorProyecto:input_type -> pb.GetColaboradoresPorProyectoRequest
-	0,  // 16: pb.CreateService.CreatePersona:input_type -> pb.CreatePersonaRequest
-	2,  // 17: pb.CreateService.UpdatePersona:input_type -> pb.UpdatePersonaRequest
-	4,  // 18: pb.CreateService.DeletePersona:input_type -> pb.DeletePersonaRequest
-	6,  // 19: pb.CreateService.CreateTicket:input_type -> pb.CreateTicketRequest
-	8,  // 20: pb.CreateService.UpdateTicket:input_type -> pb.UpdateTicketRequest
-	9,  // 21: pb.CreateService.DeleteTicket:input_type -> pb.DeleteTicketRequest
-	10, // 22: pb.CreateService.CreateProyecto:input_type -> pb.CreateProyectoRequest
-	12, // 23: pb.CreateService.UpdateProyecto:input_type -> pb.UpdateProyectoRequest
-	13, // 24: pb.CreateService.DeleteProyecto:input_type -> pb.DeleteProyectoRequest
-	28, // 25: pb.PersonasService.GetProyectos:output_type -> pb.GetProyectosResponse
-	27, // 26: pb.PersonasService.GetTickets:output_type -> pb.GetTicketsResponse
-	26, // 27: pb.PersonasService.GetPersonas:output_type -> pb.GetPersonasResponse
-	26, // 28: pb.PersonasService.GetPersonasByAgeRange:output_type -> pb.GetPersonasResponse
-	26, // 29: pb.PersonasService.GetPersonasPorNumeroDeTicket:output_type -> pb.GetPersonasResponse
-	29, // 30: pb.PersonasService.GetPersonaByNombre:output_type -> pb.PersonaResponse
-	30, // 31: pb.PersonasService.GetTicketPorNumero:output_type -> pb.TicketResponse
-	30, // 32: pb.PersonasService.GetTicketPorDueno:output_type -> pb.TicketResponse
-	31, // 33: pb.PersonasService.GetProyectoPorColaborador:output_type -> pb.ProyectoResponse
-	33, // 34: pb.PersonasService.GetColaboradoresPorProyecto:output_type -> pb.GetColaboradoresPorProyectoResponse
-	1,  // 35: pb.CreateService.CreatePersona:output_type -> pb.CreatePersonaResponse
-	3,  // 36: pb.CreateService.UpdatePersona:output_type -> pb.UpdatePersonaResponse
-	5,  // 37: pb.CreateService.DeletePersona:output_type -> pb.DeletePersonaResponse
-	7,  // 38: pb.CreateService.CreateTicket:output_type -> pb.CreateTicketResponse
-	34, // 39: pb.CreateService.UpdateTicket:output_type -> google.protobuf.Empty
-	34, // 40: pb.CreateService.DeleteTicket:output_type -> google.protobuf.Empty
-	11, // 41: pb.CreateService.CreateProyecto:output_type -> pb.CreateProyectoResponse
-	34, // 42: pb.CreateService.UpdateProyecto:output_type -> google.protobuf.Empty
-	34, // 43: pb.CreateService.DeleteProyecto:output_type -> google.protobuf.Empty
-	25, // [25:44] is the sub-list for method output_type
-	6,  // [6:25] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	65, // 0: pb.UpdatePersonaRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 1: pb.CreateTicketRequest.priority:type_name -> pb.TicketPriority
+	65, // 2: pb.UpdateTicketRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 3: pb.UpdateTicketRequest.priority:type_name -> pb.TicketPriority
+	2,  // 4: pb.CreateProyectoRequest.dificultad:type_name -> pb.Dificultad
+	65, // 5: pb.UpdateProyectoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 6: pb.UpdateProyectoRequest.dificultad:type_name -> pb.Dificultad
+	0,  // 7: pb.Ticket.status:type_name -> pb.TicketStatus
+	1,  // 8: pb.Ticket.priority:type_name -> pb.TicketPriority
+	66, // 9: pb.Ticket.created_at:type_name -> google.protobuf.Timestamp
+	66, // 10: pb.Ticket.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 11: pb.Proyecto.dificultad:type_name -> pb.Dificultad
+	31, // 12: pb.GetPersonasResponse.personas:type_name -> pb.Persona
+	32, // 13: pb.GetTicketsResponse.tickets:type_name -> pb.Ticket
+	33, // 14: pb.GetProyectosResponse.proyectos:type_name -> pb.Proyecto
+	37, // 15: pb.GetProyectosResponse.partial_failures:type_name -> pb.PartialFailure
+	31, // 16: pb.PersonaResponse.persona:type_name -> pb.Persona
+	32, // 17: pb.TicketResponse.ticket:type_name -> pb.Ticket
+	33, // 18: pb.ProyectoResponse.proyecto:type_name -> pb.Proyecto
+	32, // 19: pb.AssignTicketResponse.ticket:type_name -> pb.Ticket
+	31, // 20: pb.AssignTicketResponse.persona:type_name -> pb.Persona
+	32, // 21: pb.UnassignTicketResponse.ticket:type_name -> pb.Ticket
+	31, // 22: pb.RenamePersonaResponse.persona:type_name -> pb.Persona
+	0,  // 23: pb.TransitionTicketRequest.status:type_name -> pb.TicketStatus
+	32, // 24: pb.TransitionTicketResponse.ticket:type_name -> pb.Ticket
+	0,  // 25: pb.TransitionTicketResponse.previous_status:type_name -> pb.TicketStatus
+	66, // 26: pb.TicketComment.created_at:type_name -> google.protobuf.Timestamp
+	52, // 27: pb.ListTicketCommentsResponse.comments:type_name -> pb.TicketComment
+	57, // 28: pb.GetTicketHistoryResponse.events:type_name -> pb.TicketEvent
+	3,  // 29: pb.TicketEvent.type:type_name -> pb.TicketEventType
+	66, // 30: pb.TicketEvent.created_at:type_name -> google.protobuf.Timestamp
+	58, // 31: pb.TicketEvent.changes:type_name -> pb.FieldChange
+	60, // 32: pb.CheckIntegrityResponse.dangling:type_name -> pb.DanglingReference
+	37, // 33: pb.CheckIntegrityResponse.partial_failures:type_name -> pb.PartialFailure
+	63, // 34: pb.ReconcileTicketOwnersResponse.repairs:type_name -> pb.TicketListRepair
+	37, // 35: pb.ReconcileTicketOwnersResponse.partial_failures:type_name -> pb.PartialFailure
+	20, // 36: pb.PersonasService.GetProyectos:input_type -> pb.GetProyectosRequest
+	19, // 37: pb.PersonasService.GetTickets:input_type -> pb.GetTicketsRequest
+	18, // 38: pb.PersonasService.GetPersonas:input_type -> pb.GetPersonasRequest
+	24, // 39: pb.PersonasService.GetPersonasByAgeRange:input_type -> pb.GetPersonasByAgeRangeRequest
+	26, // 40: pb.PersonasService.GetPersonasPorNumeroDeTicket:input_type -> pb.GetPersonasPorNumeroDeTicketRequest
+	27, // 41: pb.PersonasService.GetPersonaByNombre:input_type -> pb.GetPersonaByNombreRequest
+	25, // 42: pb.PersonasService.GetTicketPorNumero:input_type -> pb.GetTicketPorNumeroRequest
+	28, // 43: pb.PersonasService.GetTicketPorDueno:input_type -> pb.GetTicketPorDuenoRequest
+	29, // 44: pb.PersonasService.ListTicketsByOwner:input_type -> pb.ListTicketsByOwnerRequest
+	30, // 45: pb.PersonasService.GetProyectoPorColaborador:input_type -> pb.GetProyectoPorColaboradorRequest
+	41, // 46: pb.PersonasService.GetColaboradoresPorProyecto:input_type -> pb.GetColaboradoresPorProyectoRequest
+	21, // 47: pb.PersonasService.StreamPersonas:input_type -> pb.StreamPersonasRequest
+	22, // 48: pb.PersonasService.StreamTickets:input_type -> pb.StreamTicketsRequest
+	23, // 49: pb.PersonasService.StreamProyectos:input_type -> pb.StreamProyectosRequest
+	4,  // 50: pb.CreateService.CreatePersona:input_type -> pb.CreatePersonaRequest
+	6,  // 51: pb.CreateService.UpdatePersona:input_type -> pb.UpdatePersonaRequest
+	8,  // 52: pb.CreateService.DeletePersona:input_type -> pb.DeletePersonaRequest
+	10, // 53: pb.CreateService.CreateTicket:input_type -> pb.CreateTicketRequest
+	12, // 54: pb.CreateService.UpdateTicket:input_type -> pb.UpdateTicketRequest
+	13, // 55: pb.CreateService.DeleteTicket:input_type -> pb.DeleteTicketRequest
+	14, // 56: pb.CreateService.CreateProyecto:input_type -> pb.CreateProyectoRequest
+	16, // 57: pb.CreateService.UpdateProyecto:input_type -> pb.UpdateProyectoRequest
+	17, // 58: pb.CreateService.DeleteProyecto:input_type -> pb.DeleteProyectoRequest
+	43, // 59: pb.CreateService.AssignTicket:input_type -> pb.AssignTicketRequest
+	45, // 60: pb.CreateService.UnassignTicket:input_type -> pb.UnassignTicketRequest
+	47, // 61: pb.CreateService.RenamePersona:input_type -> pb.RenamePersonaRequest
+	49, // 62: pb.CreateService.TransitionTicket:input_type -> pb.TransitionTicketRequest
+	51, // 63: pb.CreateService.AddTicketComment:input_type -> pb.AddTicketCommentRequest
+	53, // 64: pb.CreateService.ListTicketComments:input_type -> pb.ListTicketCommentsRequest
+	55, // 65: pb.CreateService.GetTicketHistory:input_type -> pb.GetTicketHistoryRequest
+	59, // 66: pb.AdminService.CheckIntegrity:input_type -> pb.CheckIntegrityRequest
+	62, // 67: pb.AdminService.ReconcileTicketOwners:input_type -> pb.ReconcileTicketOwnersRequest
+	36, // 68: pb.PersonasService.GetProyectos:output_type -> pb.GetProyectosResponse
+	35, // 69: pb.PersonasService.GetTickets:output_type -> pb.GetTicketsResponse
+	34, // 70: pb.PersonasService.GetPersonas:output_type -> pb.GetPersonasResponse
+	34, // 71: pb.PersonasService.GetPersonasByAgeRange:output_type -> pb.GetPersonasResponse
+	34, // 72: pb.PersonasService.GetPersonasPorNumeroDeTicket:output_type -> pb.GetPersonasResponse
+	38, // 73: pb.PersonasService.GetPersonaByNombre:output_type -> pb.PersonaResponse
+	39, // 74: pb.PersonasService.GetTicketPorNumero:output_type -> pb.TicketResponse
+	39, // 75: pb.PersonasService.GetTicketPorDueno:output_type -> pb.TicketResponse
+	35, // 76: pb.PersonasService.ListTicketsByOwner:output_type -> pb.GetTicketsResponse
+	40, // 77: pb.PersonasService.GetProyectoPorColaborador:output_type -> pb.ProyectoResponse
+	42, // 78: pb.PersonasService.GetColaboradoresPorProyecto:output_type -> pb.GetColaboradoresPorProyectoResponse
+	31, // 79: pb.PersonasService.StreamPersonas:output_type -> pb.Persona
+	32, // 80: pb.PersonasService.StreamTickets:output_type -> pb.Ticket
+	33, // 81: pb.PersonasService.StreamProyectos:output_type -> pb.Proyecto
+	5,  // 82: pb.CreateService.CreatePersona:output_type -> pb.CreatePersonaResponse
+	7,  // 83: pb.CreateService.UpdatePersona:output_type -> pb.UpdatePersonaResponse
+	9,  // 84: pb.CreateService.DeletePersona:output_type -> pb.DeletePersonaResponse
+	11, // 85: pb.CreateService.CreateTicket:output_type -> pb.CreateTicketResponse
+	67, // 86: pb.CreateService.UpdateTicket:output_type -> google.protobuf.Empty
+	67, // 87: pb.CreateService.DeleteTicket:output_type -> google.protobuf.Empty
+	15, // 88: pb.CreateService.CreateProyecto:output_type -> pb.CreateProyectoResponse
+	67, // 89: pb.CreateService.UpdateProyecto:output_type -> google.protobuf.Empty
+	67, // 90: pb.CreateService.DeleteProyecto:output_type -> google.protobuf.Empty
+	44, // 91: pb.CreateService.AssignTicket:output_type -> pb.AssignTicketResponse
+	46, // 92: pb.CreateService.UnassignTicket:output_type -> pb.UnassignTicketResponse
+	48, // 93: pb.CreateService.RenamePersona:output_type -> pb.RenamePersonaResponse
+	50, // 94: pb.CreateService.TransitionTicket:output_type -> pb.TransitionTicketResponse
+	52, // 95: pb.CreateService.AddTicketComment:output_type -> pb.TicketComment
+	54, // 96: pb.CreateService.ListTicketComments:output_type -> pb.ListTicketCommentsResponse
+	56, // 97: pb.CreateService.GetTicketHistory:output_type -> pb.GetTicketHistoryResponse
+	61, // 98: pb.AdminService.CheckIntegrity:output_type -> pb.CheckIntegrityResponse
+	64, // 99: pb.AdminService.ReconcileTicketOwners:output_type -> pb.ReconcileTicketOwnersResponse
+	68, // [68:100] is the sub-list for method output_type
+	36, // [36:68] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_proto_service_proto_init() }
@@ -2095,13 +5082,14 @@ func file_proto_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   34,
+			NumEnums:      4,
+			NumMessages:   61,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_proto_service_proto_goTypes,
 		DependencyIndexes: file_proto_service_proto_depIdxs,
+		EnumInfos:         file_proto_service_proto_enumTypes,
 		MessageInfos:      file_proto_service_proto_msgTypes,
 	}.Build()
 	File_proto_service_proto = out.File
@@ -28,8 +28,12 @@ const (
 	PersonasService_GetPersonaByNombre_FullMethodName           = "/pb.PersonasService/GetPersonaByNombre"
 	PersonasService_GetTicketPorNumero_FullMethodName           = "/pb.PersonasService/GetTicketPorNumero"
 	PersonasService_GetTicketPorDueno_FullMethodName            = "/pb.PersonasService/GetTicketPorDueno"
+	PersonasService_ListTicketsByOwner_FullMethodName           = "/pb.PersonasService/ListTicketsByOwner"
 	PersonasService_GetProyectoPorColaborador_FullMethodName    = "/pb.PersonasService/GetProyectoPorColaborador"
 	PersonasService_GetColaboradoresPorProyecto_FullMethodName  = "/pb.PersonasService/GetColaboradoresPorProyecto"
+	PersonasService_StreamPersonas_FullMethodName               = "/pb.PersonasService/StreamPersonas"
+	PersonasService_StreamTickets_FullMethodName                = "/pb.PersonasService/StreamTickets"
+	PersonasService_StreamProyectos_FullMethodName              = "/pb.PersonasService/StreamProyectos"
 )
 
 // PersonasServiceClient is the client API for PersonasService service.
@@ -45,9 +49,16 @@ type PersonasServiceClient interface {
 	GetPersonasPorNumeroDeTicket(ctx context.Context, in *GetPersonasPorNumeroDeTicketRequest, opts ...grpc.CallOption) (*GetPersonasResponse, error)
 	GetPersonaByNombre(ctx context.Context, in *GetPersonaByNombreRequest, opts ...grpc.CallOption) (*PersonaResponse, error)
 	GetTicketPorNumero(ctx context.Context, in *GetTicketPorNumeroRequest, opts ...grpc.CallOption) (*TicketResponse, error)
+	// Deprecated: Do not use.
+	// Devuelve un solo ticket aunque el dueño tenga varios. Usar ListTicketsByOwner
 	GetTicketPorDueno(ctx context.Context, in *GetTicketPorDuenoRequest, opts ...grpc.CallOption) (*TicketResponse, error)
+	ListTicketsByOwner(ctx context.Context, in *ListTicketsByOwnerRequest, opts ...grpc.CallOption) (*GetTicketsResponse, error)
 	GetProyectoPorColaborador(ctx context.Context, in *GetProyectoPorColaboradorRequest, opts ...grpc.CallOption) (*ProyectoResponse, error)
 	GetColaboradoresPorProyecto(ctx context.Context, in *GetColaboradoresPorProyectoRequest, opts ...grpc.CallOption) (*GetColaboradoresPorProyectoResponse, error)
+	// Exportan colecciones completas enviando cada documento a medida que se lee
+	StreamPersonas(ctx context.Context, in *StreamPersonasRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Persona], error)
+	StreamTickets(ctx context.Context, in *StreamTicketsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ticket], error)
+	StreamProyectos(ctx context.Context, in *StreamProyectosRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Proyecto], error)
 }
 
 type personasServiceClient struct {
@@ -128,6 +139,7 @@ func (c *personasServiceClient) GetTicketPorNumero(ctx context.Context, in *GetT
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *personasServiceClient) GetTicketPorDueno(ctx context.Context, in *GetTicketPorDuenoRequest, opts ...grpc.CallOption) (*TicketResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TicketResponse)
@@ -138,6 +150,16 @@ func (c *personasServiceClient) GetTicketPorDueno(ctx context.Context, in *GetTi
 	return out, nil
 }
 
+func (c *personasServiceClient) ListTicketsByOwner(ctx context.Context, in *ListTicketsByOwnerRequest, opts ...grpc.CallOption) (*GetTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketsResponse)
+	err := c.cc.Invoke(ctx, PersonasService_ListTicketsByOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *personasServiceClient) GetProyectoPorColaborador(ctx context.Context, in *GetProyectoPorColaboradorRequest, opts ...grpc.CallOption) (*ProyectoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProyectoResponse)
@@ -158,6 +180,63 @@ func (c *personasServiceClient) GetColaboradoresPorProyecto(ctx context.Context,
 	return out, nil
 }
 
+func (c *personasServiceClient) StreamPersonas(ctx context.Context, in *StreamPersonasRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Persona], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PersonasService_ServiceDesc.Streams[0], PersonasService_StreamPersonas_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPersonasRequest, Persona]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PersonasService_StreamPersonasClient = grpc.ServerStreamingClient[Persona]
+
+func (c *personasServiceClient) StreamTickets(ctx context.Context, in *StreamTicketsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ticket], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PersonasService_ServiceDesc.Streams[1], PersonasService_StreamTickets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamTicketsRequest, Ticket]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PersonasService_StreamTicketsClient = grpc.ServerStreamingClient[Ticket]
+
+func (c *personasServiceClient) StreamProyectos(ctx context.Context, in *StreamProyectosRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Proyecto], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PersonasService_ServiceDesc.Streams[2], PersonasService_StreamProyectos_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamProyectosRequest, Proyecto]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PersonasService_StreamProyectosClient = grpc.ServerStreamingClient[Proyecto]
+
 // PersonasServiceServer is the server API for PersonasService service.
 // All implementations must embed UnimplementedPersonasServiceServer
 // for forward compatibility.
@@ -171,9 +250,16 @@ type PersonasServiceServer interface {
 	GetPersonasPorNumeroDeTicket(context.Context, *GetPersonasPorNumeroDeTicketRequest) (*GetPersonasResponse, error)
 	GetPersonaByNombre(context.Context, *GetPersonaByNombreRequest) (*PersonaResponse, error)
 	GetTicketPorNumero(context.Context, *GetTicketPorNumeroRequest) (*TicketResponse, error)
+	// Deprecated: Do not use.
+	// Devuelve un solo ticket aunque el dueño tenga varios. Usar ListTicketsByOwner
 	GetTicketPorDueno(context.Context, *GetTicketPorDuenoRequest) (*TicketResponse, error)
+	ListTicketsByOwner(context.Context, *ListTicketsByOwnerRequest) (*GetTicketsResponse, error)
 	GetProyectoPorColaborador(context.Context, *GetProyectoPorColaboradorRequest) (*ProyectoResponse, error)
 	GetColaboradoresPorProyecto(context.Context, *GetColaboradoresPorProyectoRequest) (*GetColaboradoresPorProyectoResponse, error)
+	// Exportan colecciones completas enviando cada documento a medida que se lee
+	StreamPersonas(*StreamPersonasRequest, grpc.ServerStreamingServer[Persona]) error
+	StreamTickets(*StreamTicketsRequest, grpc.ServerStreamingServer[Ticket]) error
+	StreamProyectos(*StreamProyectosRequest, grpc.ServerStreamingServer[Proyecto]) error
 	mustEmbedUnimplementedPersonasServiceServer()
 }
 
@@ -208,12 +294,24 @@ func (UnimplementedPersonasServiceServer) GetTicketPorNumero(context.Context, *G
 func (UnimplementedPersonasServiceServer) GetTicketPorDueno(context.Context, *GetTicketPorDuenoRequest) (*TicketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTicketPorDueno not implemented")
 }
+func (UnimplementedPersonasServiceServer) ListTicketsByOwner(context.Context, *ListTicketsByOwnerRequest) (*GetTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketsByOwner not implemented")
+}
 func (UnimplementedPersonasServiceServer) GetProyectoPorColaborador(context.Context, *GetProyectoPorColaboradorRequest) (*ProyectoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProyectoPorColaborador not implemented")
 }
 func (UnimplementedPersonasServiceServer) GetColaboradoresPorProyecto(context.Context, *GetColaboradoresPorProyectoRequest) (*GetColaboradoresPorProyectoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetColaboradoresPorProyecto not implemented")
 }
+func (UnimplementedPersonasServiceServer) StreamPersonas(*StreamPersonasRequest, grpc.ServerStreamingServer[Persona]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPersonas not implemented")
+}
+func (UnimplementedPersonasServiceServer) StreamTickets(*StreamTicketsRequest, grpc.ServerStreamingServer[Ticket]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTickets not implemented")
+}
+func (UnimplementedPersonasServiceServer) StreamProyectos(*StreamProyectosRequest, grpc.ServerStreamingServer[Proyecto]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProyectos not implemented")
+}
 func (UnimplementedPersonasServiceServer) mustEmbedUnimplementedPersonasServiceServer() {}
 func (UnimplementedPersonasServiceServer) testEmbeddedByValue()                         {}
 
@@ -379,6 +477,24 @@ func _PersonasService_GetTicketPorDueno_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PersonasService_ListTicketsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonasServiceServer).ListTicketsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersonasService_ListTicketsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonasServiceServer).ListTicketsByOwner(ctx, req.(*ListTicketsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PersonasService_GetProyectoPorColaborador_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProyectoPorColaboradorRequest)
 	if err := dec(in); err != nil {
@@ -415,6 +531,39 @@ func _PersonasService_GetColaboradoresPorProyecto_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PersonasService_StreamPersonas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPersonasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PersonasServiceServer).StreamPersonas(m, &grpc.GenericServerStream[StreamPersonasRequest, Persona]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PersonasService_StreamPersonasServer = grpc.ServerStreamingServer[Persona]
+
+func _PersonasService_StreamTickets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTicketsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PersonasServiceServer).StreamTickets(m, &grpc.GenericServerStream[StreamTicketsRequest, Ticket]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PersonasService_StreamTicketsServer = grpc.ServerStreamingServer[Ticket]
+
+func _PersonasService_StreamProyectos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProyectosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PersonasServiceServer).StreamProyectos(m, &grpc.GenericServerStream[StreamProyectosRequest, Proyecto]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PersonasService_StreamProyectosServer = grpc.ServerStreamingServer[Proyecto]
+
 // PersonasService_ServiceDesc is the grpc.ServiceDesc for PersonasService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -454,6 +603,10 @@ var PersonasService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTicketPorDueno",
 			Handler:    _PersonasService_GetTicketPorDueno_Handler,
 		},
+		{
+			MethodName: "ListTicketsByOwner",
+			Handler:    _PersonasService_ListTicketsByOwner_Handler,
+		},
 		{
 			MethodName: "GetProyectoPorColaborador",
 			Handler:    _PersonasService_GetProyectoPorColaborador_Handler,
@@ -463,20 +616,43 @@ var PersonasService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PersonasService_GetColaboradoresPorProyecto_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPersonas",
+			Handler:       _PersonasService_StreamPersonas_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTickets",
+			Handler:       _PersonasService_StreamTickets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamProyectos",
+			Handler:       _PersonasService_StreamProyectos_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/service.proto",
 }
 
 const (
-	CreateService_CreatePersona_FullMethodName  = "/pb.CreateService/CreatePersona"
-	CreateService_UpdatePersona_FullMethodName  = "/pb.CreateService/UpdatePersona"
-	CreateService_DeletePersona_FullMethodName  = "/pb.CreateService/DeletePersona"
-	CreateService_CreateTicket_FullMethodName   = "/pb.CreateService/CreateTicket"
-	CreateService_UpdateTicket_FullMethodName   = "/pb.CreateService/UpdateTicket"
-	CreateService_DeleteTicket_FullMethodName   = "/pb.CreateService/DeleteTicket"
-	CreateService_CreateProyecto_FullMethodName = "/pb.CreateService/CreateProyecto"
-	CreateService_UpdateProyecto_FullMethodName = "/pb.CreateService/UpdateProyecto"
-	CreateService_DeleteProyecto_FullMethodName = "/pb.CreateService/DeleteProyecto"
+	CreateService_CreatePersona_FullMethodName      = "/pb.CreateService/CreatePersona"
+	CreateService_UpdatePersona_FullMethodName      = "/pb.CreateService/UpdatePersona"
+	CreateService_DeletePersona_FullMethodName      = "/pb.CreateService/DeletePersona"
+	CreateService_CreateTicket_FullMethodName       = "/pb.CreateService/CreateTicket"
+	CreateService_UpdateTicket_FullMethodName       = "/pb.CreateService/UpdateTicket"
+	CreateService_DeleteTicket_FullMethodName       = "/pb.CreateService/DeleteTicket"
+	CreateService_CreateProyecto_FullMethodName     = "/pb.CreateService/CreateProyecto"
+	CreateService_UpdateProyecto_FullMethodName     = "/pb.CreateService/UpdateProyecto"
+	CreateService_DeleteProyecto_FullMethodName     = "/pb.CreateService/DeleteProyecto"
+	CreateService_AssignTicket_FullMethodName       = "/pb.CreateService/AssignTicket"
+	CreateService_UnassignTicket_FullMethodName     = "/pb.CreateService/UnassignTicket"
+	CreateService_RenamePersona_FullMethodName      = "/pb.CreateService/RenamePersona"
+	CreateService_TransitionTicket_FullMethodName   = "/pb.CreateService/TransitionTicket"
+	CreateService_AddTicketComment_FullMethodName   = "/pb.CreateService/AddTicketComment"
+	CreateService_ListTicketComments_FullMethodName = "/pb.CreateService/ListTicketComments"
+	CreateService_GetTicketHistory_FullMethodName   = "/pb.CreateService/GetTicketHistory"
 )
 
 // CreateServiceClient is the client API for CreateService service.
@@ -495,6 +671,18 @@ type CreateServiceClient interface {
 	CreateProyecto(ctx context.Context, in *CreateProyectoRequest, opts ...grpc.CallOption) (*CreateProyectoResponse, error)
 	UpdateProyecto(ctx context.Context, in *UpdateProyectoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteProyecto(ctx context.Context, in *DeleteProyectoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Métodos que modifican varias colecciones en una transacción: se aplican
+	// todos los cambios o ninguno
+	AssignTicket(ctx context.Context, in *AssignTicketRequest, opts ...grpc.CallOption) (*AssignTicketResponse, error)
+	UnassignTicket(ctx context.Context, in *UnassignTicketRequest, opts ...grpc.CallOption) (*UnassignTicketResponse, error)
+	RenamePersona(ctx context.Context, in *RenamePersonaRequest, opts ...grpc.CallOption) (*RenamePersonaResponse, error)
+	// Cambia el estado de un ticket según las transiciones configuradas
+	TransitionTicket(ctx context.Context, in *TransitionTicketRequest, opts ...grpc.CallOption) (*TransitionTicketResponse, error)
+	// Comentarios e historial de cambios de los tickets. El historial lo
+	// escribe el servidor y no se puede modificar.
+	AddTicketComment(ctx context.Context, in *AddTicketCommentRequest, opts ...grpc.CallOption) (*TicketComment, error)
+	ListTicketComments(ctx context.Context, in *ListTicketCommentsRequest, opts ...grpc.CallOption) (*ListTicketCommentsResponse, error)
+	GetTicketHistory(ctx context.Context, in *GetTicketHistoryRequest, opts ...grpc.CallOption) (*GetTicketHistoryResponse, error)
 }
 
 type createServiceClient struct {
@@ -595,6 +783,76 @@ func (c *createServiceClient) DeleteProyecto(ctx context.Context, in *DeleteProy
 	return out, nil
 }
 
+func (c *createServiceClient) AssignTicket(ctx context.Context, in *AssignTicketRequest, opts ...grpc.CallOption) (*AssignTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignTicketResponse)
+	err := c.cc.Invoke(ctx, CreateService_AssignTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *createServiceClient) UnassignTicket(ctx context.Context, in *UnassignTicketRequest, opts ...grpc.CallOption) (*UnassignTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignTicketResponse)
+	err := c.cc.Invoke(ctx, CreateService_UnassignTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *createServiceClient) RenamePersona(ctx context.Context, in *RenamePersonaRequest, opts ...grpc.CallOption) (*RenamePersonaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenamePersonaResponse)
+	err := c.cc.Invoke(ctx, CreateService_RenamePersona_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *createServiceClient) TransitionTicket(ctx context.Context, in *TransitionTicketRequest, opts ...grpc.CallOption) (*TransitionTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionTicketResponse)
+	err := c.cc.Invoke(ctx, CreateService_TransitionTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *createServiceClient) AddTicketComment(ctx context.Context, in *AddTicketCommentRequest, opts ...grpc.CallOption) (*TicketComment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketComment)
+	err := c.cc.Invoke(ctx, CreateService_AddTicketComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *createServiceClient) ListTicketComments(ctx context.Context, in *ListTicketCommentsRequest, opts ...grpc.CallOption) (*ListTicketCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketCommentsResponse)
+	err := c.cc.Invoke(ctx, CreateService_ListTicketComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *createServiceClient) GetTicketHistory(ctx context.Context, in *GetTicketHistoryRequest, opts ...grpc.CallOption) (*GetTicketHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketHistoryResponse)
+	err := c.cc.Invoke(ctx, CreateService_GetTicketHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CreateServiceServer is the server API for CreateService service.
 // All implementations must embed UnimplementedCreateServiceServer
 // for forward compatibility.
@@ -611,6 +869,18 @@ type CreateServiceServer interface {
 	CreateProyecto(context.Context, *CreateProyectoRequest) (*CreateProyectoResponse, error)
 	UpdateProyecto(context.Context, *UpdateProyectoRequest) (*emptypb.Empty, error)
 	DeleteProyecto(context.Context, *DeleteProyectoRequest) (*emptypb.Empty, error)
+	// Métodos que modifican varias colecciones en una transacción: se aplican
+	// todos los cambios o ninguno
+	AssignTicket(context.Context, *AssignTicketRequest) (*AssignTicketResponse, error)
+	UnassignTicket(context.Context, *UnassignTicketRequest) (*UnassignTicketResponse, error)
+	RenamePersona(context.Context, *RenamePersonaRequest) (*RenamePersonaResponse, error)
+	// Cambia el estado de un ticket según las transiciones configuradas
+	TransitionTicket(context.Context, *TransitionTicketRequest) (*TransitionTicketResponse, error)
+	// Comentarios e historial de cambios de los tickets. El historial lo
+	// escribe el servidor y no se puede modificar.
+	AddTicketComment(context.Context, *AddTicketCommentRequest) (*TicketComment, error)
+	ListTicketComments(context.Context, *ListTicketCommentsRequest) (*ListTicketCommentsResponse, error)
+	GetTicketHistory(context.Context, *GetTicketHistoryRequest) (*GetTicketHistoryResponse, error)
 	mustEmbedUnimplementedCreateServiceServer()
 }
 
@@ -648,6 +918,27 @@ func (UnimplementedCreateServiceServer) UpdateProyecto(context.Context, *UpdateP
 func (UnimplementedCreateServiceServer) DeleteProyecto(context.Context, *DeleteProyectoRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProyecto not implemented")
 }
+func (UnimplementedCreateServiceServer) AssignTicket(context.Context, *AssignTicketRequest) (*AssignTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTicket not implemented")
+}
+func (UnimplementedCreateServiceServer) UnassignTicket(context.Context, *UnassignTicketRequest) (*UnassignTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignTicket not implemented")
+}
+func (UnimplementedCreateServiceServer) RenamePersona(context.Context, *RenamePersonaRequest) (*RenamePersonaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePersona not implemented")
+}
+func (UnimplementedCreateServiceServer) TransitionTicket(context.Context, *TransitionTicketRequest) (*TransitionTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionTicket not implemented")
+}
+func (UnimplementedCreateServiceServer) AddTicketComment(context.Context, *AddTicketCommentRequest) (*TicketComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTicketComment not implemented")
+}
+func (UnimplementedCreateServiceServer) ListTicketComments(context.Context, *ListTicketCommentsRequest) (*ListTicketCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketComments not implemented")
+}
+func (UnimplementedCreateServiceServer) GetTicketHistory(context.Context, *GetTicketHistoryRequest) (*GetTicketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketHistory not implemented")
+}
 func (UnimplementedCreateServiceServer) mustEmbedUnimplementedCreateServiceServer() {}
 func (UnimplementedCreateServiceServer) testEmbeddedByValue()                       {}
 
@@ -831,6 +1122,132 @@ func _CreateService_DeleteProyecto_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CreateService_AssignTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreateServiceServer).AssignTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreateService_AssignTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreateServiceServer).AssignTicket(ctx, req.(*AssignTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreateService_UnassignTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreateServiceServer).UnassignTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreateService_UnassignTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreateServiceServer).UnassignTicket(ctx, req.(*UnassignTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreateService_RenamePersona_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePersonaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreateServiceServer).RenamePersona(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreateService_RenamePersona_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreateServiceServer).RenamePersona(ctx, req.(*RenamePersonaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreateService_TransitionTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreateServiceServer).TransitionTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreateService_TransitionTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreateServiceServer).TransitionTicket(ctx, req.(*TransitionTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreateService_AddTicketComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTicketCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreateServiceServer).AddTicketComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreateService_AddTicketComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreateServiceServer).AddTicketComment(ctx, req.(*AddTicketCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreateService_ListTicketComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreateServiceServer).ListTicketComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreateService_ListTicketComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreateServiceServer).ListTicketComments(ctx, req.(*ListTicketCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreateService_GetTicketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreateServiceServer).GetTicketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreateService_GetTicketHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreateServiceServer).GetTicketHistory(ctx, req.(*GetTicketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CreateService_ServiceDesc is the grpc.ServiceDesc for CreateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -874,6 +1291,182 @@ var CreateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProyecto",
 			Handler:    _CreateService_DeleteProyecto_Handler,
 		},
+		{
+			MethodName: "AssignTicket",
+			Handler:    _CreateService_AssignTicket_Handler,
+		},
+		{
+			MethodName: "UnassignTicket",
+			Handler:    _CreateService_UnassignTicket_Handler,
+		},
+		{
+			MethodName: "RenamePersona",
+			Handler:    _CreateService_RenamePersona_Handler,
+		},
+		{
+			MethodName: "TransitionTicket",
+			Handler:    _CreateService_TransitionTicket_Handler,
+		},
+		{
+			MethodName: "AddTicketComment",
+			Handler:    _CreateService_AddTicketComment_Handler,
+		},
+		{
+			MethodName: "ListTicketComments",
+			Handler:    _CreateService_ListTicketComments_Handler,
+		},
+		{
+			MethodName: "GetTicketHistory",
+			Handler:    _CreateService_GetTicketHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/service.proto",
+}
+
+const (
+	AdminService_CheckIntegrity_FullMethodName        = "/pb.AdminService/CheckIntegrity"
+	AdminService_ReconcileTicketOwners_FullMethodName = "/pb.AdminService/ReconcileTicketOwners"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Servicio de administración
+type AdminServiceClient interface {
+	// Recorre las colecciones y devuelve las referencias a documentos que no existen
+	CheckIntegrity(ctx context.Context, in *CheckIntegrityRequest, opts ...grpc.CallOption) (*CheckIntegrityResponse, error)
+	// Rehace personas.tickets a partir del dueño de cada ticket
+	ReconcileTicketOwners(ctx context.Context, in *ReconcileTicketOwnersRequest, opts ...grpc.CallOption) (*ReconcileTicketOwnersResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) CheckIntegrity(ctx context.Context, in *CheckIntegrityRequest, opts ...grpc.CallOption) (*CheckIntegrityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckIntegrityResponse)
+	err := c.cc.Invoke(ctx, AdminService_CheckIntegrity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReconcileTicketOwners(ctx context.Context, in *ReconcileTicketOwnersRequest, opts ...grpc.CallOption) (*ReconcileTicketOwnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileTicketOwnersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReconcileTicketOwners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// Servicio de administración
+type AdminServiceServer interface {
+	// Recorre las colecciones y devuelve las referencias a documentos que no existen
+	CheckIntegrity(context.Context, *CheckIntegrityRequest) (*CheckIntegrityResponse, error)
+	// Rehace personas.tickets a partir del dueño de cada ticket
+	ReconcileTicketOwners(context.Context, *ReconcileTicketOwnersRequest) (*ReconcileTicketOwnersResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) CheckIntegrity(context.Context, *CheckIntegrityRequest) (*CheckIntegrityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIntegrity not implemented")
+}
+func (UnimplementedAdminServiceServer) ReconcileTicketOwners(context.Context, *ReconcileTicketOwnersRequest) (*ReconcileTicketOwnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileTicketOwners not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_CheckIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CheckIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CheckIntegrity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CheckIntegrity(ctx, req.(*CheckIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReconcileTicketOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileTicketOwnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReconcileTicketOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReconcileTicketOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReconcileTicketOwners(ctx, req.(*ReconcileTicketOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckIntegrity",
+			Handler:    _AdminService_CheckIntegrity_Handler,
+		},
+		{
+			MethodName: "ReconcileTicketOwners",
+			Handler:    _AdminService_ReconcileTicketOwners_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/service.proto",
@@ -0,0 +1,396 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Operadores de comparación soportados en los filtros
+const (
+	opEq  = "="
+	opNe  = "!="
+	opLt  = "<"
+	opLte = "<="
+	opGt  = ">"
+	opGte = ">="
+	opHas = ":"
+)
+
+// expr es un nodo del árbol de un filtro ya validado
+type expr interface {
+	isExpr()
+}
+
+type andExpr struct{ terms []expr }
+type orExpr struct{ terms []expr }
+type notExpr struct{ term expr }
+
+// compareExpr compara un campo con un valor ya convertido al tipo del campo
+type compareExpr struct {
+	field string
+	kind  Kind
+	op    string
+	value interface{}
+}
+
+func (andExpr) isExpr()     {}
+func (orExpr) isExpr()      {}
+func (notExpr) isExpr()     {}
+func (compareExpr) isExpr() {}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokOperator
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// tokenize separa el filtro en tokens. Los strings van entre comillas dobles
+// o simples y admiten \" y \\ como escapes.
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(input) {
+		c, size := utf8.DecodeRuneInString(input[i:])
+		switch {
+		case unicode.IsSpace(c):
+			i += size
+		case c == '(':
+			tokens = append(tokens, token{tokLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokRParen, ")", i})
+			i++
+		case c == '"' || c == '\'':
+			start := i
+			var sb strings.Builder
+			i++
+			for {
+				if i >= len(input) {
+					return nil, errorf(start, "string sin cerrar")
+				}
+				if input[i] == '\\' && i+1 < len(input) {
+					sb.WriteByte(input[i+1])
+					i += 2
+					continue
+				}
+				if input[i] == byte(c) {
+					i++
+					break
+				}
+				sb.WriteByte(input[i])
+				i++
+			}
+			tokens = append(tokens, token{tokString, sb.String(), start})
+		case strings.ContainsRune("=!<>:", c):
+			start := i
+			op := string(c)
+			if i+1 < len(input) && input[i+1] == '=' && c != '=' && c != ':' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, errorf(start, "operador inválido %q", op)
+			}
+			tokens = append(tokens, token{tokOperator, op, start})
+			i += len(op)
+		case c == '-' || unicode.IsDigit(c):
+			start := i
+			i++
+			for i < len(input) && unicode.IsDigit(rune(input[i])) {
+				i++
+			}
+			tokens = append(tokens, token{tokNumber, input[start:i], start})
+		case c == '_' || c == '.' || unicode.IsLetter(c):
+			start := i
+			for i < len(input) {
+				r, size := utf8.DecodeRuneInString(input[i:])
+				if r != '_' && r != '.' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, token{tokIdent, input[start:i], start})
+		default:
+			return nil, errorf(i, "carácter inesperado %q", c)
+		}
+	}
+	return append(tokens, token{tokEOF, "", len(input)}), nil
+}
+
+// Quote devuelve s como un string del filtro, con los escapes que acepta
+// tokenize, para armar filtros con valores que no escribió el usuario
+func Quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// parser implementa el subconjunto de AIP-160 que aceptan los listados:
+//
+//	expression  = sequence { "AND" sequence }
+//	sequence    = factor { factor }            (AND implícito)
+//	factor      = term { "OR" term }
+//	term        = [ "NOT" ] simple
+//	simple      = "(" expression ")" | restriction
+//	restriction = field comparator value
+//
+// Igual que en AIP-160, OR tiene mayor precedencia que AND.
+type parser struct {
+	tokens []token
+	pos    int
+	schema Schema
+}
+
+func parseFilter(input string, schema Schema) (expr, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, schema: schema}
+	e, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, errorf(t.pos, "token inesperado %q", t.text)
+	}
+	return e, nil
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) keyword(word string) bool {
+	t := p.peek()
+	return t.kind == tokIdent && t.text == word
+}
+
+func (p *parser) expression() (expr, error) {
+	terms, err := p.list(p.sequence, "AND")
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return andExpr{terms}, nil
+}
+
+func (p *parser) sequence() (expr, error) {
+	var terms []expr
+	for {
+		term, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+
+		t := p.peek()
+		startsTerm := t.kind == tokLParen || (t.kind == tokIdent && t.text != "AND" && t.text != "OR")
+		if !startsTerm {
+			break
+		}
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return andExpr{terms}, nil
+}
+
+func (p *parser) factor() (expr, error) {
+	terms, err := p.list(p.term, "OR")
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return orExpr{terms}, nil
+}
+
+// list lee uno o más elementos separados por la palabra clave sep
+func (p *parser) list(item func() (expr, error), sep string) ([]expr, error) {
+	var terms []expr
+	for {
+		term, err := item()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+		if !p.keyword(sep) {
+			return terms, nil
+		}
+		p.next()
+	}
+}
+
+func (p *parser) term() (expr, error) {
+	if p.keyword("NOT") {
+		p.next()
+		term, err := p.simple()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{term}, nil
+	}
+	return p.simple()
+}
+
+func (p *parser) simple() (expr, error) {
+	if p.peek().kind == tokLParen {
+		p.next()
+		e, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokRParen {
+			return nil, errorf(t.pos, "se esperaba ')'")
+		}
+		return e, nil
+	}
+	return p.restriction()
+}
+
+func (p *parser) restriction() (expr, error) {
+	field := p.next()
+	if field.kind != tokIdent {
+		return nil, errorf(field.pos, "se esperaba un campo, se encontró %q", field.text)
+	}
+	kind, ok := p.schema[field.text]
+	if !ok {
+		return nil, errorf(field.pos, "el campo %q no se puede filtrar (campos permitidos: %s)", field.text, p.schema.names())
+	}
+
+	op := p.next()
+	if op.kind != tokOperator {
+		return nil, errorf(op.pos, "se esperaba un operador después de %q", field.text)
+	}
+	if err := checkOperator(kind, op); err != nil {
+		return nil, err
+	}
+
+	raw := p.next()
+	value, err := convertValue(kind, raw)
+	if err != nil {
+		return nil, err
+	}
+	return compareExpr{field: field.text, kind: kind, op: op.text, value: value}, nil
+}
+
+// checkOperator valida que el operador tenga sentido para el tipo del campo
+func checkOperator(kind Kind, op token) error {
+	if kind.isList() {
+		switch op.text {
+		case opEq, opNe, opHas:
+			return nil
+		}
+		return errorf(op.pos, "el operador %q no se puede usar con listas (usar =, != o :)", op.text)
+	}
+	return nil
+}
+
+// convertValue convierte el valor del filtro al tipo del campo
+func convertValue(kind Kind, t token) (interface{}, error) {
+	switch t.kind {
+	case tokString, tokIdent, tokNumber:
+	default:
+		return nil, errorf(t.pos, "se esperaba un valor, se encontró %q", t.text)
+	}
+
+	if desc := kind.enum(); desc != nil {
+		return enumValue(desc, t)
+	}
+
+	switch kind.scalar() {
+	case Int:
+		if t.kind == tokString {
+			return nil, errorf(t.pos, "se esperaba un número, se encontró %q", t.text)
+		}
+		n, err := strconv.ParseInt(t.text, 10, 32)
+		if err != nil {
+			return nil, errorf(t.pos, "número inválido %q", t.text)
+		}
+		return int32(n), nil
+	default:
+		return t.text, nil
+	}
+}
+
+// enumValue convierte el número o el nombre de un valor del enum desc
+func enumValue(desc protoreflect.EnumDescriptor, t token) (interface{}, error) {
+	if t.kind == tokNumber {
+		n, err := strconv.ParseInt(t.text, 10, 32)
+		if err != nil {
+			return nil, errorf(t.pos, "número inválido %q", t.text)
+		}
+		return int32(n), nil
+	}
+
+	prefix := enumPrefix(desc.Name())
+	values := desc.Values()
+	names := make([]string, values.Len())
+	for i := range names {
+		value := values.Get(i)
+		name := string(value.Name())
+		if strings.EqualFold(t.text, name) || strings.EqualFold(t.text, strings.TrimPrefix(name, prefix)) {
+			return int32(value.Number()), nil
+		}
+		names[i] = name
+	}
+	return nil, errorf(t.pos, "valor inválido %q (valores permitidos: %s)", t.text, strings.Join(names, ", "))
+}
+
+// enumPrefix devuelve el prefijo de los valores de un enum según la guía de
+// estilo de proto: TicketStatus usa TICKET_STATUS_
+func enumPrefix(name protoreflect.Name) string {
+	var sb strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			sb.WriteByte('_')
+		}
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+	return sb.String() + "_"
+}
+
+// Error describe un filtro u orden inválido. Pos es la posición en el texto
+// original, o -1 si el error no corresponde a una posición.
+type Error struct {
+	Pos     int
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Pos < 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("posición %d: %s", e.Pos, e.Message)
+}
+
+func errorf(pos int, format string, args ...interface{}) error {
+	return &Error{Pos: pos, Message: fmt.Sprintf(format, args...)}
+}
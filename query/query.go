@@ -0,0 +1,456 @@
+// Package query interpreta los parámetros filter (subconjunto de AIP-160) y
+// order_by de los listados, los valida contra los campos permitidos de cada
+// entidad y los traduce a consultas de MongoDB o a comparaciones en memoria.
+package query
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Kind es el tipo de un campo filtrable
+type Kind int
+
+const (
+	String Kind = iota
+	Int
+	StringList
+	IntList
+)
+
+// firstEnum es el primer Kind que corresponde a un enum registrado con Enum
+const firstEnum Kind = 100
+
+// enums son los enums registrados con Enum, en el orden de sus Kind
+var enums []protoreflect.EnumDescriptor
+
+// Enum devuelve el tipo de un campo enum de proto. El valor se guarda y se
+// compara como número, de modo que se ordena según la numeración del enum; en
+// los filtros se puede escribir el número o el nombre del valor, con o sin el
+// prefijo del enum y sin distinguir mayúsculas (DIFICULTAD_DIFICIL, dificil, 3).
+// Se debe llamar al inicializar el paquete que declara el Schema.
+func Enum(desc protoreflect.EnumDescriptor) Kind {
+	enums = append(enums, desc)
+	return firstEnum + Kind(len(enums)-1)
+}
+
+// enum devuelve el descriptor de un Kind creado con Enum, o nil
+func (k Kind) enum() protoreflect.EnumDescriptor {
+	if k < firstEnum || int(k-firstEnum) >= len(enums) {
+		return nil
+	}
+	return enums[k-firstEnum]
+}
+
+func (k Kind) isList() bool { return k == StringList || k == IntList }
+
+// scalar devuelve el tipo de los elementos de una lista, o el mismo tipo
+func (k Kind) scalar() Kind {
+	switch k {
+	case StringList:
+		return String
+	case IntList:
+		return Int
+	}
+	return k
+}
+
+// Schema lista los campos que se pueden usar en filter y order_by. Los nombres
+// coinciden con los del mensaje proto y con los del documento de MongoDB.
+type Schema map[string]Kind
+
+func (s Schema) names() string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// OrderField es un campo de order_by con su dirección
+type OrderField struct {
+	Field string
+	Desc  bool
+	Kind  Kind
+}
+
+// Query es un filtro y un orden ya validados. Un *Query nil no filtra y
+// ordena solo por _id.
+type Query struct {
+	filter      expr
+	orderBy     []OrderField
+	fingerprint string
+}
+
+// Parse valida filter y orderBy contra schema. Los errores son de tipo *Error.
+func Parse(filter, orderBy string, schema Schema) (*Query, error) {
+	f, err := parseFilter(filter, schema)
+	if err != nil {
+		return nil, err
+	}
+	order, err := parseOrderBy(orderBy, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256([]byte(strings.TrimSpace(filter) + "\x00" + strings.TrimSpace(orderBy)))
+	return &Query{filter: f, orderBy: order, fingerprint: hex.EncodeToString(sum[:8])}, nil
+}
+
+// parseOrderBy interpreta una lista separada por comas de "campo [asc|desc]"
+func parseOrderBy(input string, schema Schema) ([]OrderField, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+
+	var order []OrderField
+	seen := map[string]bool{}
+	for _, part := range strings.Split(input, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 || len(words) > 2 {
+			return nil, &Error{Pos: -1, Message: fmt.Sprintf("order_by inválido: %q", strings.TrimSpace(part))}
+		}
+
+		field := OrderField{Field: words[0]}
+		kind, ok := schema[field.Field]
+		field.Kind = kind
+		if !ok {
+			return nil, &Error{Pos: -1, Message: fmt.Sprintf("no se puede ordenar por %q (campos permitidos: %s)", field.Field, schema.names())}
+		}
+		if kind.isList() {
+			return nil, &Error{Pos: -1, Message: fmt.Sprintf("no se puede ordenar por la lista %q", field.Field)}
+		}
+		if seen[field.Field] {
+			return nil, &Error{Pos: -1, Message: fmt.Sprintf("el campo %q aparece más de una vez en order_by", field.Field)}
+		}
+		seen[field.Field] = true
+
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				field.Desc = true
+			default:
+				return nil, &Error{Pos: -1, Message: fmt.Sprintf("dirección inválida %q (usar asc o desc)", words[1])}
+			}
+		}
+		order = append(order, field)
+	}
+	return order, nil
+}
+
+// Fingerprint identifica el filtro y el orden; los tokens de página lo guardan
+// para rechazar su uso con una consulta distinta
+func (q *Query) Fingerprint() string {
+	if q == nil {
+		return ""
+	}
+	return q.fingerprint
+}
+
+// OrderBy devuelve los campos de orden, sin incluir el _id de desempate
+func (q *Query) OrderBy() []OrderField {
+	if q == nil {
+		return nil
+	}
+	return q.orderBy
+}
+
+// MongoFilter traduce el filtro a una consulta de MongoDB
+func (q *Query) MongoFilter() bson.M {
+	if q == nil || q.filter == nil {
+		return bson.M{}
+	}
+	return mongoExpr(q.filter)
+}
+
+func mongoExpr(e expr) bson.M {
+	switch e := e.(type) {
+	case andExpr:
+		return bson.M{"$and": mongoTerms(e.terms)}
+	case orExpr:
+		return bson.M{"$or": mongoTerms(e.terms)}
+	case notExpr:
+		return bson.M{"$nor": bson.A{mongoExpr(e.term)}}
+	case compareExpr:
+		// Un string vacío también coincide con el campo ausente, como en Match,
+		// que lee el valor por defecto de proto
+		if e.value == "" && !e.kind.isList() {
+			switch e.op {
+			case opEq:
+				return bson.M{e.field: bson.M{"$in": bson.A{nil, ""}}}
+			case opNe:
+				return bson.M{e.field: bson.M{"$nin": bson.A{nil, ""}}}
+			}
+		}
+		// En MongoDB la igualdad sobre un arreglo significa "contiene"
+		switch e.op {
+		case opEq, opHas:
+			return bson.M{e.field: e.value}
+		case opNe:
+			return bson.M{e.field: bson.M{"$ne": e.value}}
+		case opLt:
+			return bson.M{e.field: bson.M{"$lt": e.value}}
+		case opLte:
+			return bson.M{e.field: bson.M{"$lte": e.value}}
+		case opGt:
+			return bson.M{e.field: bson.M{"$gt": e.value}}
+		case opGte:
+			return bson.M{e.field: bson.M{"$gte": e.value}}
+		}
+	}
+	panic(fmt.Sprintf("query: expresión desconocida %T", e))
+}
+
+func mongoTerms(terms []expr) bson.A {
+	out := make(bson.A, len(terms))
+	for i, term := range terms {
+		out[i] = mongoExpr(term)
+	}
+	return out
+}
+
+// MongoSort devuelve el orden pedido seguido de _id para desempatar
+func (q *Query) MongoSort() bson.D {
+	var sortDoc bson.D
+	for _, f := range q.OrderBy() {
+		dir := 1
+		if f.Desc {
+			dir = -1
+		}
+		sortDoc = append(sortDoc, bson.E{Key: f.Field, Value: dir})
+	}
+	return append(sortDoc, bson.E{Key: "_id", Value: 1})
+}
+
+// MongoAfter devuelve la condición que selecciona los documentos posteriores
+// al último de la página anterior, según el orden de la consulta. Un valor nil
+// es un campo ausente o null, que MongoDB ordena antes que cualquier string o
+// número; {campo: nil} coincide con los dos.
+func (q *Query) MongoAfter(values []interface{}, lastID interface{}) bson.M {
+	order := q.OrderBy()
+	var or bson.A
+	for i := 0; i <= len(order); i++ {
+		cond := bson.M{}
+		for j := 0; j < i; j++ {
+			cond[order[j].Field] = values[j]
+		}
+		if i == len(order) {
+			cond["_id"] = bson.M{"$gt": lastID}
+			or = append(or, cond)
+			continue
+		}
+
+		field, value := order[i].Field, values[i]
+		switch {
+		case !order[i].Desc && value == nil:
+			// Después de null viene todo lo que no es null
+			cond[field] = bson.M{"$ne": nil}
+		case !order[i].Desc:
+			cond[field] = bson.M{"$gt": value}
+		case value == nil:
+			// En orden descendente no hay nada después de null
+			continue
+		default:
+			// $lt no coincide con null, que en orden descendente va al final
+			cond["$or"] = bson.A{bson.M{field: bson.M{"$lt": value}}, bson.M{field: nil}}
+		}
+		or = append(or, cond)
+	}
+	return bson.M{"$or": or}
+}
+
+// Match evalúa el filtro sobre un mensaje proto, con la misma semántica que MongoDB
+func (q *Query) Match(msg proto.Message) bool {
+	if q == nil || q.filter == nil {
+		return true
+	}
+	return match(q.filter, msg.ProtoReflect())
+}
+
+func match(e expr, msg protoreflect.Message) bool {
+	switch e := e.(type) {
+	case andExpr:
+		for _, term := range e.terms {
+			if !match(term, msg) {
+				return false
+			}
+		}
+		return true
+	case orExpr:
+		for _, term := range e.terms {
+			if match(term, msg) {
+				return true
+			}
+		}
+		return false
+	case notExpr:
+		return !match(e.term, msg)
+	case compareExpr:
+		value := fieldValue(msg, e.field)
+		if list, ok := value.([]interface{}); ok {
+			contains := slices.ContainsFunc(list, func(v interface{}) bool {
+				c, err := Compare(v, e.value)
+				return err == nil && c == 0
+			})
+			if e.op == opNe {
+				return !contains
+			}
+			return contains
+		}
+		// convertValue ya llevó el valor al tipo del campo, así que Compare no
+		// falla; si fallara, el documento no cumple la condición
+		c, err := Compare(value, e.value)
+		if err != nil {
+			return false
+		}
+		switch e.op {
+		case opEq, opHas:
+			return c == 0
+		case opNe:
+			return c != 0
+		case opLt:
+			return c < 0
+		case opLte:
+			return c <= 0
+		case opGt:
+			return c > 0
+		case opGte:
+			return c >= 0
+		}
+	}
+	panic(fmt.Sprintf("query: expresión desconocida %T", e))
+}
+
+// CheckValues verifica que values tenga un valor del tipo de cada campo de
+// orden, o nil para un campo que faltaba en el documento. Los campos numéricos
+// aceptan float64, que es como guarda mongosh los números. Los valores llegan
+// en los tokens de página, que puede modificar el cliente.
+func (q *Query) CheckValues(values []interface{}) error {
+	order := q.OrderBy()
+	if len(values) != len(order) {
+		return fmt.Errorf("query: se esperaban %d valores de orden, se recibieron %d", len(order), len(values))
+	}
+	for i, f := range order {
+		if values[i] == nil {
+			continue
+		}
+		var ok bool
+		if f.Kind == String {
+			_, ok = values[i].(string)
+		} else if _, isFloat := values[i].(float64); isFloat {
+			ok = true
+		} else {
+			_, ok = toInt64(values[i])
+		}
+		if !ok {
+			return fmt.Errorf("query: valor %T inválido para el campo %q", values[i], f.Field)
+		}
+	}
+	return nil
+}
+
+// SortValues devuelve los valores de los campos de orden de un mensaje
+func (q *Query) SortValues(msg proto.Message) []interface{} {
+	order := q.OrderBy()
+	values := make([]interface{}, len(order))
+	for i, f := range order {
+		values[i] = fieldValue(msg.ProtoReflect(), f.Field)
+	}
+	return values
+}
+
+// CompareValues compara dos listas de valores de orden respetando la
+// dirección de cada campo
+func (q *Query) CompareValues(a, b []interface{}) (int, error) {
+	for i, f := range q.OrderBy() {
+		c, err := Compare(a[i], b[i])
+		if err != nil {
+			return 0, err
+		}
+		if f.Desc {
+			c = -c
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// Compare ordena dos valores escalares del mismo tipo (enteros o strings).
+// Devuelve un error si los tipos no coinciden.
+func Compare(a, b interface{}) (int, error) {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), nil
+		}
+	default:
+		if x, ok := toInt64(a); ok {
+			if y, ok := toInt64(b); ok {
+				switch {
+				case x < y:
+					return -1, nil
+				case x > y:
+					return 1, nil
+				}
+				return 0, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("query: no se pueden comparar %T y %T", a, b)
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// fieldValue lee un campo del mensaje por su nombre proto. Las listas se
+// devuelven como []interface{}.
+func fieldValue(msg protoreflect.Message, name string) interface{} {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		panic(fmt.Sprintf("query: el mensaje %s no tiene el campo %q", msg.Descriptor().FullName(), name))
+	}
+	if fd.IsList() {
+		list := msg.Get(fd).List()
+		out := make([]interface{}, list.Len())
+		for i := range out {
+			out[i] = scalarValue(fd, list.Get(i))
+		}
+		return out
+	}
+	return scalarValue(fd, msg.Get(fd))
+}
+
+func scalarValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return int32(v.Int())
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int()
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.EnumKind:
+		return int32(v.Enum())
+	}
+	panic(fmt.Sprintf("query: tipo de campo no soportado %s", fd.Kind()))
+}
@@ -0,0 +1,268 @@
+package query
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pb "go-grpc-mongo/proto"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// personas es el schema de las pruebas, con un campo de cada tipo
+var personas = Schema{
+	"nombre":  String,
+	"edad":    Int,
+	"tickets": IntList,
+}
+
+var dificultad = Enum(pb.Dificultad(0).Descriptor())
+
+var proyectos = Schema{
+	"nombre":     String,
+	"dificultad": dificultad,
+}
+
+func mustParse(t *testing.T, filter, orderBy string, schema Schema) *Query {
+	t.Helper()
+	q, err := Parse(filter, orderBy, schema)
+	if err != nil {
+		t.Fatalf("Parse(%q, %q): %v", filter, orderBy, err)
+	}
+	return q
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		orderBy string
+		pos     int // posición esperada del error; -1 si no corresponde
+	}{
+		{"campo desconocido", `apellido = "x"`, "", 0},
+		{"falta el operador", `nombre "x"`, "", 7},
+		{"falta el valor", `nombre =`, "", 8},
+		{"texto en un número", `edad = "x"`, "", 7},
+		{"número fuera de rango", `edad > 9999999999`, "", 7},
+		{"menor que en una lista", `tickets < 3`, "", 8},
+		{"paréntesis sin cerrar", `(nombre = "x"`, "", 13},
+		{"token sobrante", `nombre = "x")`, "", 12},
+		{"string sin cerrar", `nombre = "x`, "", 9},
+		{"orden por campo desconocido", "", "apellido", -1},
+		{"orden por lista", "", "tickets", -1},
+		{"orden repetido", "", "edad, edad desc", -1},
+		{"dirección inválida", "", "edad abajo", -1},
+		{"orden con palabras de más", "", "edad asc desc", -1},
+		{"orden vacío entre comas", "", "edad,,nombre", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.filter, tt.orderBy, personas)
+			var qerr *Error
+			if !errors.As(err, &qerr) {
+				t.Fatalf("Parse() error = %v, se esperaba *Error", err)
+			}
+			if qerr.Pos != tt.pos {
+				t.Errorf("Parse() posición = %d (%v), se esperaba %d", qerr.Pos, err, tt.pos)
+			}
+		})
+	}
+}
+
+func TestParseEnum(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   int32
+	}{
+		{"dificultad = DIFICULTAD_DIFICIL", int32(pb.Dificultad_DIFICULTAD_DIFICIL)},
+		{"dificultad = dificil", int32(pb.Dificultad_DIFICULTAD_DIFICIL)},
+		{"dificultad = 2", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			q := mustParse(t, tt.filter, "", proyectos)
+			want := bson.M{"dificultad": tt.want}
+			if got := q.MongoFilter(); !reflect.DeepEqual(got, want) {
+				t.Errorf("MongoFilter() = %v, se esperaba %v", got, want)
+			}
+		})
+	}
+	if _, err := Parse("dificultad = imposible", "", proyectos); err == nil {
+		t.Error("Parse() aceptó un valor que no existe en el enum")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	ana := &pb.Persona{Nombre: "Ana", Edad: 30, Tickets: []int32{104, 105}}
+	tests := []struct {
+		filter string
+		mongo  bson.M
+		match  bool // si ana cumple el filtro
+	}{
+		{`nombre = "Ana"`, bson.M{"nombre": "Ana"}, true},
+		{`nombre != "Ana"`, bson.M{"nombre": bson.M{"$ne": "Ana"}}, false},
+		{`edad >= 30`, bson.M{"edad": bson.M{"$gte": int32(30)}}, true},
+		{`edad < 30`, bson.M{"edad": bson.M{"$lt": int32(30)}}, false},
+		{`tickets : 104`, bson.M{"tickets": int32(104)}, true},
+		{`tickets != 106`, bson.M{"tickets": bson.M{"$ne": int32(106)}}, true},
+		// El string vacío también coincide con el campo ausente
+		{`nombre = ""`, bson.M{"nombre": bson.M{"$in": bson.A{nil, ""}}}, false},
+		{`nombre != ""`, bson.M{"nombre": bson.M{"$nin": bson.A{nil, ""}}}, true},
+		{`NOT edad > 40`, bson.M{"$nor": bson.A{bson.M{"edad": bson.M{"$gt": int32(40)}}}}, true},
+		// OR tiene mayor precedencia que AND
+		{`edad > 40 OR nombre = "Ana" AND edad = 30`, bson.M{"$and": bson.A{
+			bson.M{"$or": bson.A{bson.M{"edad": bson.M{"$gt": int32(40)}}, bson.M{"nombre": "Ana"}}},
+			bson.M{"edad": int32(30)},
+		}}, true},
+		{`edad = 30 (nombre = "Eva")`, bson.M{"$and": bson.A{bson.M{"edad": int32(30)}, bson.M{"nombre": "Eva"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			q := mustParse(t, tt.filter, "", personas)
+			if got := q.MongoFilter(); !reflect.DeepEqual(got, tt.mongo) {
+				t.Errorf("MongoFilter() = %v, se esperaba %v", got, tt.mongo)
+			}
+			if got := q.Match(ana); got != tt.match {
+				t.Errorf("Match() = %v, se esperaba %v", got, tt.match)
+			}
+		})
+	}
+}
+
+func TestMongoSort(t *testing.T) {
+	q := mustParse(t, "", "edad desc, nombre", personas)
+	want := bson.D{{Key: "edad", Value: -1}, {Key: "nombre", Value: 1}, {Key: "_id", Value: 1}}
+	if got := q.MongoSort(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MongoSort() = %v, se esperaba %v", got, want)
+	}
+	var empty *Query
+	if got := empty.MongoSort(); !reflect.DeepEqual(got, bson.D{{Key: "_id", Value: 1}}) {
+		t.Errorf("MongoSort() sin orden = %v, se esperaba solo _id", got)
+	}
+}
+
+func TestMongoAfter(t *testing.T) {
+	const id = "persona-9"
+	tests := []struct {
+		name    string
+		orderBy string
+		values  []interface{}
+		want    bson.A
+	}{
+		{"sin orden", "", nil, bson.A{
+			bson.M{"_id": bson.M{"$gt": id}},
+		}},
+		{"ascendente", "nombre", []interface{}{"Ana"}, bson.A{
+			bson.M{"nombre": bson.M{"$gt": "Ana"}},
+			bson.M{"nombre": "Ana", "_id": bson.M{"$gt": id}},
+		}},
+		// null va antes que cualquier valor, así que lo siguiente es todo lo que no es null
+		{"ascendente después de null", "nombre", []interface{}{nil}, bson.A{
+			bson.M{"nombre": bson.M{"$ne": nil}},
+			bson.M{"nombre": nil, "_id": bson.M{"$gt": id}},
+		}},
+		// En orden descendente null va al final
+		{"descendente", "edad desc", []interface{}{int64(30)}, bson.A{
+			bson.M{"$or": bson.A{bson.M{"edad": bson.M{"$lt": int64(30)}}, bson.M{"edad": nil}}},
+			bson.M{"edad": int64(30), "_id": bson.M{"$gt": id}},
+		}},
+		{"descendente después de null", "edad desc", []interface{}{nil}, bson.A{
+			bson.M{"edad": nil, "_id": bson.M{"$gt": id}},
+		}},
+		{"dos campos", "edad desc, nombre", []interface{}{nil, "Ana"}, bson.A{
+			bson.M{"edad": nil, "nombre": bson.M{"$gt": "Ana"}},
+			bson.M{"edad": nil, "nombre": "Ana", "_id": bson.M{"$gt": id}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := mustParse(t, "", tt.orderBy, personas)
+			want := bson.M{"$or": tt.want}
+			if got := q.MongoAfter(tt.values, id); !reflect.DeepEqual(got, want) {
+				t.Errorf("MongoAfter() = %v, se esperaba %v", got, want)
+			}
+		})
+	}
+}
+
+func TestCheckValues(t *testing.T) {
+	q := mustParse(t, "", "nombre, edad desc", personas)
+	tests := []struct {
+		name   string
+		values []interface{}
+		ok     bool
+	}{
+		{"valores del tipo de cada campo", []interface{}{"Ana", int32(30)}, true},
+		{"int64", []interface{}{"Ana", int64(30)}, true},
+		{"número de mongosh", []interface{}{"Ana", 30.5}, true},
+		{"campos ausentes", []interface{}{nil, nil}, true},
+		{"faltan valores", []interface{}{"Ana"}, false},
+		{"sobran valores", []interface{}{"Ana", int32(30), "x"}, false},
+		{"número en un string", []interface{}{int32(1), int32(30)}, false},
+		{"string en un número", []interface{}{"Ana", "30"}, false},
+		{"booleano", []interface{}{"Ana", true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := q.CheckValues(tt.values); (err == nil) != tt.ok {
+				t.Errorf("CheckValues() = %v, se esperaba ok = %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b interface{}
+		want int
+		ok   bool
+	}{
+		{"strings", "Ana", "Eva", -1, true},
+		{"strings iguales", "Ana", "Ana", 0, true},
+		{"enteros de distinto tamaño", int64(31), int32(30), 1, true},
+		{"int y int32", 30, int32(30), 0, true},
+		{"string y número", "30", int32(30), 0, false},
+		{"número y string", int32(30), "30", 0, false},
+		{"nil", nil, "Ana", 0, false},
+		{"float64", 30.0, int32(30), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compare(tt.a, tt.b)
+			if (err == nil) != tt.ok {
+				t.Fatalf("Compare() error = %v, se esperaba ok = %v", err, tt.ok)
+			}
+			if got != tt.want {
+				t.Errorf("Compare() = %d, se esperaba %d", got, tt.want)
+			}
+		})
+	}
+
+	q := mustParse(t, "", "edad desc, nombre", personas)
+	got, err := q.CompareValues([]interface{}{int32(30), "Ana"}, []interface{}{int32(30), "Eva"})
+	if err != nil || got != -1 {
+		t.Errorf("CompareValues() = %d, %v; se esperaba -1", got, err)
+	}
+	got, err = q.CompareValues([]interface{}{int32(40), "Eva"}, []interface{}{int32(30), "Ana"})
+	if err != nil || got != -1 {
+		t.Errorf("CompareValues() descendente = %d, %v; se esperaba -1", got, err)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	a := mustParse(t, `edad > 30`, "nombre", personas)
+	b := mustParse(t, ` edad > 30 `, "nombre ", personas)
+	c := mustParse(t, `edad > 30`, "nombre desc", personas)
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Error("Fingerprint() cambia con los espacios de los extremos")
+	}
+	if a.Fingerprint() == c.Fingerprint() {
+		t.Error("Fingerprint() no cambia con el orden")
+	}
+	var empty *Query
+	if empty.Fingerprint() != "" {
+		t.Error("Fingerprint() de un *Query nil no es vacío")
+	}
+}
@@ -0,0 +1,782 @@
+package store
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/query"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewMemoryStore crea repositorios en memoria con el mismo comportamiento que
+// los de MongoDB. Se usa en entornos sin base de datos (CI, desarrollo local).
+func NewMemoryStore() *Store {
+	gate := &sync.RWMutex{}
+	personas := newMemoryTable[*pb.Persona](gate)
+	tickets := newMemoryTable[*pb.Ticket](gate, "ticket_numero")
+	proyectos := newMemoryTable[*pb.Proyecto](gate, "nombre")
+	comments := newMemoryTable[*pb.TicketComment](gate)
+	history := newMemoryTable[*pb.TicketEvent](gate)
+	return &Store{
+		Personas:   &memoryPersonas{table: personas},
+		Tickets:    &memoryTickets{table: tickets},
+		Proyectos:  &memoryProyectos{table: proyectos},
+		References: &memoryReferences{personas: personas, tickets: tickets, proyectos: proyectos},
+		Idempotency: &memoryIdempotency{
+			records: make(map[string]IdempotencyRecord),
+			now:     time.Now,
+		},
+		Counters: &memoryCounters{values: make(map[string]int32)},
+		Comments: &memoryTicketComments{table: comments},
+		History:  &memoryTicketHistory{table: history},
+		UnitOfWork: &memoryUnitOfWork{
+			gate:   gate,
+			tables: []memorySnapshotter{personas, tickets, proyectos, comments, history},
+		},
+	}
+}
+
+// memoryTable guarda los documentos en orden de inserción, igual que el
+// orden natural de una colección de MongoDB sin índices.
+type memoryTable[T proto.Message] struct {
+	mu   sync.RWMutex
+	ids  []string
+	docs map[string]T
+	// unique son los campos con índice único en MongoDB (ver db.RequiredIndexes)
+	unique []string
+	// gate es compartido por todas las tablas del store (ver memoryUnitOfWork)
+	gate *sync.RWMutex
+}
+
+func newMemoryTable[T proto.Message](gate *sync.RWMutex, unique ...string) *memoryTable[T] {
+	return &memoryTable[T]{docs: make(map[string]T), unique: unique, gate: gate}
+}
+
+// enter espera a que termine la transacción en curso, salvo que ctx sea el de
+// esa transacción, y devuelve la función que la habilita de nuevo
+func (t *memoryTable[T]) enter(ctx context.Context) func() {
+	if ctx.Value(memoryTxKey{}) == t.gate {
+		return func() {}
+	}
+	t.gate.RLock()
+	return t.gate.RUnlock
+}
+
+// snapshot guarda el estado de la tabla y devuelve la función que lo restaura.
+// Alcanza con copiar el mapa porque los documentos guardados se reemplazan y
+// nunca se modifican.
+func (t *memoryTable[T]) snapshot() func() {
+	t.mu.RLock()
+	ids, docs := slices.Clone(t.ids), maps.Clone(t.docs)
+	t.mu.RUnlock()
+	return func() {
+		t.mu.Lock()
+		t.ids, t.docs = ids, docs
+		t.mu.Unlock()
+	}
+}
+
+// conflicts indica si otro documento ya tiene el valor de doc en un campo único
+func (t *memoryTable[T]) conflicts(doc T, id string) bool {
+	msg := doc.ProtoReflect()
+	for _, field := range t.unique {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(field))
+		for otherID, other := range t.docs {
+			if otherID != id && other.ProtoReflect().Get(fd).Equal(msg.Get(fd)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// clone evita que quien llama modifique los documentos guardados
+func clone[T proto.Message](doc T) T {
+	return proto.Clone(doc).(T)
+}
+
+// insert guarda una copia del documento con un ObjectID nuevo y la versión
+// inicial, y devuelve su ID
+func (t *memoryTable[T]) insert(ctx context.Context, doc T, setID func(T, string)) (string, error) {
+	defer t.enter(ctx)()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id := primitive.NewObjectID().Hex()
+	doc = clone(doc)
+	setID(doc, id)
+	setVersion(doc, InitialVersion)
+	if t.conflicts(doc, id) {
+		return "", ErrAlreadyExists
+	}
+	t.ids = append(t.ids, id)
+	t.docs[id] = doc
+	return id, nil
+}
+
+// find devuelve copias de todos los documentos que cumplen match
+func (t *memoryTable[T]) find(ctx context.Context, match func(T) bool) []T {
+	defer t.enter(ctx)()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var result []T
+	for _, id := range t.ids {
+		if doc := t.docs[id]; match(doc) {
+			result = append(result, clone(doc))
+		}
+	}
+	return result
+}
+
+// page devuelve los documentos que cumplen q, en el orden de q y luego por
+// ID, a partir del token recibido
+func (t *memoryTable[T]) page(ctx context.Context, q *query.Query, page Page) ([]T, string, error) {
+	token, err := decodePageToken(page.Token, q)
+	if err != nil {
+		return nil, "", err
+	}
+	var last string
+	if token != nil {
+		var ok bool
+		if last, ok = token.LastID.(string); !ok {
+			return nil, "", ErrInvalidPageToken
+		}
+	}
+
+	type entry struct {
+		id     string
+		doc    T
+		values []interface{}
+	}
+	// Los valores de los documentos siempre se pueden comparar. Los del token
+	// se verificaron en decodePageToken, pero pueden ser nil, que en memoria
+	// no se generan y se rechazan al comparar.
+	compare := func(a, b entry) (int, error) {
+		if c, err := q.CompareValues(a.values, b.values); c != 0 || err != nil {
+			return c, err
+		}
+		return strings.Compare(a.id, b.id), nil
+	}
+
+	defer t.enter(ctx)()
+	t.mu.RLock()
+	var entries []entry
+	for id, doc := range t.docs {
+		if q.Match(doc) {
+			entries = append(entries, entry{id: id, doc: clone(doc), values: q.SortValues(doc)})
+		}
+	}
+	t.mu.RUnlock()
+	slices.SortFunc(entries, func(a, b entry) int {
+		c, _ := compare(a, b)
+		return c
+	})
+
+	var items []T
+	var ids []interface{}
+	for _, e := range entries {
+		if token != nil {
+			c, err := compare(e, entry{id: last, values: token.Values})
+			if err != nil {
+				return nil, "", ErrInvalidPageToken
+			}
+			if c <= 0 {
+				continue
+			}
+		}
+		if page.Size > 0 && len(items) > int(page.Size) {
+			break
+		}
+		items = append(items, e.doc)
+		ids = append(ids, e.id)
+	}
+	return trimPage(items, ids, q, page)
+}
+
+// each llama a send con una copia de cada documento ordenado por ID. Se
+// detiene si se cancela ctx o si send devuelve error.
+func (t *memoryTable[T]) each(ctx context.Context, send func(T) error) error {
+	docs, _, err := t.page(ctx, nil, Page{})
+	if err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := send(doc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findOne devuelve el primer documento que cumple match, o ErrNotFound
+func (t *memoryTable[T]) findOne(ctx context.Context, match func(T) bool) (T, error) {
+	defer t.enter(ctx)()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for _, id := range t.ids {
+		if doc := t.docs[id]; match(doc) {
+			return clone(doc), nil
+		}
+	}
+	var zero T
+	return zero, ErrNotFound
+}
+
+// version lee el campo version de un documento
+func version(doc proto.Message) int64 {
+	msg := doc.ProtoReflect()
+	return msg.Get(msg.Descriptor().Fields().ByName("version")).Int()
+}
+
+// setVersion reemplaza el campo version de un documento. Los comentarios y
+// los eventos no tienen versión porque no se modifican.
+func setVersion(doc proto.Message, version int64) {
+	msg := doc.ProtoReflect()
+	if fd := msg.Descriptor().Fields().ByName("version"); fd != nil {
+		msg.Set(fd, protoreflect.ValueOfInt64(version))
+	}
+}
+
+// get devuelve una copia del documento con el ID indicado
+func (t *memoryTable[T]) get(ctx context.Context, id string) (T, error) {
+	var zero T
+	if err := checkID(id); err != nil {
+		return zero, err
+	}
+
+	defer t.enter(ctx)()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	doc, ok := t.docs[id]
+	if !ok {
+		return zero, ErrNotFound
+	}
+	return clone(doc), nil
+}
+
+// count devuelve cuántos documentos cumplen match
+func (t *memoryTable[T]) count(ctx context.Context, match func(T) bool) int64 {
+	defer t.enter(ctx)()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var n int64
+	for _, doc := range t.docs {
+		if match(doc) {
+			n++
+		}
+	}
+	return n
+}
+
+// updateWhere aplica apply a los documentos que cumplen match, aumenta su
+// versión y devuelve cuántos se modificaron
+func (t *memoryTable[T]) updateWhere(ctx context.Context, match func(T) bool, apply func(T)) int64 {
+	defer t.enter(ctx)()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var n int64
+	for id, doc := range t.docs {
+		if match(doc) {
+			// Se reemplaza el documento en lugar de modificarlo, para que las
+			// copias que guarda una transacción en curso no cambien
+			doc = clone(doc)
+			apply(doc)
+			setVersion(doc, version(doc)+1)
+			t.docs[id] = doc
+			n++
+		}
+	}
+	return n
+}
+
+// update copia los campos de fields de src al documento con el ID indicado si
+// la versión de src es la actual, y aumenta la versión
+func (t *memoryTable[T]) update(ctx context.Context, id string, src T, fields []string) error {
+	if err := checkID(id); err != nil {
+		return err
+	}
+
+	defer t.enter(ctx)()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	doc, ok := t.docs[id]
+	if !ok {
+		return ErrNotFound
+	}
+	if current := version(doc); current != version(src) {
+		return &VersionMismatchError{Current: current}
+	}
+	// Los cambios se aplican sobre una copia que reemplaza al documento solo si
+	// no viola un campo único. Se copia desde un clon de src para no compartir
+	// listas con quien llama.
+	doc = clone(doc)
+	from := clone(src).ProtoReflect()
+	to := doc.ProtoReflect()
+	for _, field := range fields {
+		fd := to.Descriptor().Fields().ByName(protoreflect.Name(field))
+		// Los campos vacíos se borran; Get devuelve listas de solo lectura
+		if !from.Has(fd) {
+			to.Clear(fd)
+			continue
+		}
+		to.Set(fd, from.Get(fd))
+	}
+	if t.conflicts(doc, id) {
+		return ErrAlreadyExists
+	}
+	setVersion(doc, version(doc)+1)
+	t.docs[id] = doc
+	return nil
+}
+
+// delete elimina el documento con el ID indicado si su versión es la esperada
+func (t *memoryTable[T]) delete(ctx context.Context, id string, expected int64) error {
+	if err := checkID(id); err != nil {
+		return err
+	}
+
+	defer t.enter(ctx)()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	doc, ok := t.docs[id]
+	if !ok {
+		return ErrNotFound
+	}
+	if current := version(doc); current != expected {
+		return &VersionMismatchError{Current: current}
+	}
+	delete(t.docs, id)
+	t.ids = slices.DeleteFunc(t.ids, func(other string) bool { return other == id })
+	return nil
+}
+
+// Los Stream nunca llaman a failed: en memoria los documentos se guardan ya
+// decodificados
+
+type memoryPersonas struct {
+	table *memoryTable[*pb.Persona]
+}
+
+func (r *memoryPersonas) List(ctx context.Context, q *query.Query, page Page) ([]*pb.Persona, string, error) {
+	return r.table.page(ctx, q, page)
+}
+
+func (r *memoryPersonas) Stream(ctx context.Context, send func(*pb.Persona) error, _ func(DecodeFailure) error) error {
+	return r.table.each(ctx, send)
+}
+
+func (r *memoryPersonas) ListByAgeRange(ctx context.Context, edadMinima, edadMaxima int32) ([]*pb.Persona, error) {
+	return r.table.find(ctx, func(p *pb.Persona) bool {
+		return p.Edad >= edadMinima && p.Edad <= edadMaxima
+	}), nil
+}
+
+func (r *memoryPersonas) ListByTicket(ctx context.Context, ticketNumero int32) ([]*pb.Persona, error) {
+	return r.table.find(ctx, withTicket(ticketNumero)), nil
+}
+
+func (r *memoryPersonas) Get(ctx context.Context, id string) (*pb.Persona, error) {
+	return r.table.get(ctx, id)
+}
+
+func (r *memoryPersonas) ListByIDs(ctx context.Context, ids []string) ([]*pb.Persona, error) {
+	return r.table.find(ctx, func(p *pb.Persona) bool { return slices.Contains(ids, p.Id) }), nil
+}
+
+func (r *memoryPersonas) GetByNombre(ctx context.Context, nombre string) (*pb.Persona, error) {
+	return r.table.findOne(ctx, func(p *pb.Persona) bool { return p.Nombre == nombre })
+}
+
+func (r *memoryPersonas) Create(ctx context.Context, persona *pb.Persona) (string, error) {
+	return r.table.insert(ctx, persona, func(p *pb.Persona, id string) { p.Id = id })
+}
+
+func (r *memoryPersonas) Update(ctx context.Context, persona *pb.Persona, fields []string) error {
+	return r.table.update(ctx, persona.Id, persona, fields)
+}
+
+func (r *memoryPersonas) Delete(ctx context.Context, id string, version int64) error {
+	return r.table.delete(ctx, id, version)
+}
+
+type memoryTickets struct {
+	table *memoryTable[*pb.Ticket]
+}
+
+func (r *memoryTickets) List(ctx context.Context, q *query.Query, page Page) ([]*pb.Ticket, string, error) {
+	return r.table.page(ctx, q, page)
+}
+
+func (r *memoryTickets) Stream(ctx context.Context, send func(*pb.Ticket) error, _ func(DecodeFailure) error) error {
+	return r.table.each(ctx, send)
+}
+
+func (r *memoryTickets) Get(ctx context.Context, id string) (*pb.Ticket, error) {
+	return r.table.get(ctx, id)
+}
+
+func (r *memoryTickets) GetByNumero(ctx context.Context, ticketNumero int32) (*pb.Ticket, error) {
+	return r.table.findOne(ctx, func(t *pb.Ticket) bool { return t.TicketNumero == ticketNumero })
+}
+
+func (r *memoryTickets) GetByOwner(ctx context.Context, owner string) (*pb.Ticket, error) {
+	return r.table.findOne(ctx, func(t *pb.Ticket) bool { return t.Owner == owner })
+}
+
+func (r *memoryTickets) GetByOwnerID(ctx context.Context, ownerID string) (*pb.Ticket, error) {
+	return r.table.findOne(ctx, func(t *pb.Ticket) bool { return t.OwnerId == ownerID })
+}
+
+func (r *memoryTickets) ListByOwner(ctx context.Context, owner Ref) ([]*pb.Ticket, error) {
+	return r.table.find(ctx, ownedBy(owner)), nil
+}
+
+func (r *memoryTickets) Create(ctx context.Context, ticket *pb.Ticket) (string, error) {
+	ticket = clone(ticket)
+	ticket.CreatedAt = timestamppb.New(now())
+	ticket.UpdatedAt = ticket.CreatedAt
+	return r.table.insert(ctx, ticket, func(t *pb.Ticket, id string) { t.Id = id })
+}
+
+func (r *memoryTickets) Update(ctx context.Context, ticket *pb.Ticket, fields []string) error {
+	ticket = clone(ticket)
+	ticket.UpdatedAt = timestamppb.New(now())
+	return r.table.update(ctx, ticket.Id, ticket, append(slices.Clip(fields), "updated_at"))
+}
+
+func (r *memoryTickets) Delete(ctx context.Context, id string, version int64) error {
+	return r.table.delete(ctx, id, version)
+}
+
+type memoryProyectos struct {
+	table *memoryTable[*pb.Proyecto]
+}
+
+// List nunca omite documentos: en memoria se guardan ya decodificados
+func (r *memoryProyectos) List(ctx context.Context, q *query.Query, page Page) ([]*pb.Proyecto, string, []DecodeFailure, error) {
+	proyectos, next, err := r.table.page(ctx, q, page)
+	return proyectos, next, nil, err
+}
+
+func (r *memoryProyectos) Stream(ctx context.Context, send func(*pb.Proyecto) error, _ func(DecodeFailure) error) error {
+	return r.table.each(ctx, send)
+}
+
+func (r *memoryProyectos) Get(ctx context.Context, id string) (*pb.Proyecto, error) {
+	return r.table.get(ctx, id)
+}
+
+func (r *memoryProyectos) ListByIDs(ctx context.Context, ids []string) ([]*pb.Proyecto, error) {
+	return r.table.find(ctx, func(p *pb.Proyecto) bool { return slices.Contains(ids, p.Id) }), nil
+}
+
+func (r *memoryProyectos) GetByNombre(ctx context.Context, nombre string) (*pb.Proyecto, error) {
+	return r.table.findOne(ctx, func(p *pb.Proyecto) bool { return p.Nombre == nombre })
+}
+
+func (r *memoryProyectos) GetByColaborador(ctx context.Context, colaborador string) (*pb.Proyecto, error) {
+	return r.table.findOne(ctx, func(p *pb.Proyecto) bool { return slices.Contains(p.Colaboradores, colaborador) })
+}
+
+func (r *memoryProyectos) GetByColaboradorID(ctx context.Context, colaboradorID string) (*pb.Proyecto, error) {
+	return r.table.findOne(ctx, func(p *pb.Proyecto) bool { return slices.Contains(p.ColaboradorIds, colaboradorID) })
+}
+
+func (r *memoryProyectos) Create(ctx context.Context, proyecto *pb.Proyecto) (string, error) {
+	return r.table.insert(ctx, proyecto, func(p *pb.Proyecto, id string) { p.Id = id })
+}
+
+func (r *memoryProyectos) Update(ctx context.Context, proyecto *pb.Proyecto, fields []string) error {
+	return r.table.update(ctx, proyecto.Id, proyecto, fields)
+}
+
+func (r *memoryProyectos) Delete(ctx context.Context, id string, version int64) error {
+	return r.table.delete(ctx, id, version)
+}
+
+// memoryCounters no participa de las transacciones: un número asignado no se
+// reutiliza aunque falle la creación del ticket
+type memoryCounters struct {
+	mu     sync.Mutex
+	values map[string]int32
+}
+
+func (r *memoryCounters) Next(ctx context.Context, name string, first int32) (int32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[name] = max(r.values[name], first-1) + 1
+	return r.values[name], nil
+}
+
+type memoryTicketComments struct {
+	table *memoryTable[*pb.TicketComment]
+}
+
+func (r *memoryTicketComments) Add(ctx context.Context, comment *pb.TicketComment) (*pb.TicketComment, error) {
+	comment = clone(comment)
+	comment.CreatedAt = timestamppb.New(now())
+	id, err := r.table.insert(ctx, comment, func(c *pb.TicketComment, id string) { c.Id = id })
+	if err != nil {
+		return nil, err
+	}
+	comment.Id = id
+	return comment, nil
+}
+
+func (r *memoryTicketComments) List(ctx context.Context, ticketID string, page Page) ([]*pb.TicketComment, string, error) {
+	q, err := byTicket(ticketID)
+	if err != nil {
+		return nil, "", err
+	}
+	return r.table.page(ctx, q, page)
+}
+
+type memoryTicketHistory struct {
+	table *memoryTable[*pb.TicketEvent]
+}
+
+func (r *memoryTicketHistory) Append(ctx context.Context, event *pb.TicketEvent) error {
+	event = clone(event)
+	event.CreatedAt = timestamppb.New(now())
+	_, err := r.table.insert(ctx, event, func(e *pb.TicketEvent, id string) { e.Id = id })
+	return err
+}
+
+func (r *memoryTicketHistory) List(ctx context.Context, ticketID string, page Page) ([]*pb.TicketEvent, string, error) {
+	q, err := byTicket(ticketID)
+	if err != nil {
+		return nil, "", err
+	}
+	return r.table.page(ctx, q, page)
+}
+
+type memoryReferences struct {
+	personas  *memoryTable[*pb.Persona]
+	tickets   *memoryTable[*pb.Ticket]
+	proyectos *memoryTable[*pb.Proyecto]
+}
+
+// Los predicados de referencias comparan el ID si el documento lo tiene y si
+// no, en los documentos anteriores a las referencias por ID, el nombre
+
+func ownedBy(persona Ref) func(*pb.Ticket) bool {
+	return func(t *pb.Ticket) bool {
+		if t.OwnerId != "" {
+			return t.OwnerId == persona.ID
+		}
+		return persona.Nombre != "" && t.Owner == persona.Nombre
+	}
+}
+
+func withColaborador(persona Ref) func(*pb.Proyecto) bool {
+	return func(p *pb.Proyecto) bool {
+		if len(p.ColaboradorIds) > 0 {
+			return slices.Contains(p.ColaboradorIds, persona.ID)
+		}
+		return persona.Nombre != "" && slices.Contains(p.Colaboradores, persona.Nombre)
+	}
+}
+
+func withTicket(ticketNumero int32) func(*pb.Persona) bool {
+	return func(p *pb.Persona) bool { return slices.Contains(p.Tickets, ticketNumero) }
+}
+
+func inProyecto(proyecto Ref) func(*pb.Persona) bool {
+	return func(p *pb.Persona) bool {
+		if p.ProyectoId != "" {
+			return p.ProyectoId == proyecto.ID
+		}
+		return proyecto.Nombre != "" && p.Proyecto == proyecto.Nombre
+	}
+}
+
+// colaboradorAt indica si el colaborador en la posición i es persona.
+// colaboradores y colaborador_ids van en el mismo orden.
+func colaboradorAt(p *pb.Proyecto, i int, persona Ref) bool {
+	if len(p.ColaboradorIds) > 0 {
+		return i < len(p.ColaboradorIds) && p.ColaboradorIds[i] == persona.ID
+	}
+	return p.Colaboradores[i] == persona.Nombre
+}
+
+func (r *memoryReferences) PersonaReferences(ctx context.Context, persona Ref) (References, error) {
+	return References{
+		Tickets:   r.tickets.count(ctx, ownedBy(persona)),
+		Proyectos: r.proyectos.count(ctx, withColaborador(persona)),
+	}, nil
+}
+
+func (r *memoryReferences) TicketReferences(ctx context.Context, ticketNumero int32) (References, error) {
+	return References{Personas: r.personas.count(ctx, withTicket(ticketNumero))}, nil
+}
+
+func (r *memoryReferences) ProyectoReferences(ctx context.Context, proyecto Ref) (References, error) {
+	return References{Personas: r.personas.count(ctx, inProyecto(proyecto))}, nil
+}
+
+func (r *memoryReferences) ReleasePersona(ctx context.Context, persona, reassignTo Ref) (References, error) {
+	tickets := r.tickets.updateWhere(ctx, ownedBy(persona), func(t *pb.Ticket) {
+		t.Owner = reassignTo.Nombre
+		t.OwnerId = reassignTo.ID
+		t.Huerfano = reassignTo == Ref{}
+	})
+	proyectos := r.proyectos.updateWhere(ctx, withColaborador(persona), func(p *pb.Proyecto) {
+		var nombres, ids []string
+		for i := range p.Colaboradores {
+			if colaboradorAt(p, i, persona) {
+				continue
+			}
+			nombres = append(nombres, p.Colaboradores[i])
+			if i < len(p.ColaboradorIds) {
+				ids = append(ids, p.ColaboradorIds[i])
+			}
+		}
+		p.Colaboradores, p.ColaboradorIds = nombres, ids
+	})
+	return References{Tickets: tickets, Proyectos: proyectos}, nil
+}
+
+func (r *memoryReferences) ReleaseTicket(ctx context.Context, ticketNumero int32) (References, error) {
+	personas := r.personas.updateWhere(ctx, withTicket(ticketNumero), func(p *pb.Persona) {
+		p.Tickets = slices.DeleteFunc(p.Tickets, func(n int32) bool { return n == ticketNumero })
+	})
+	return References{Personas: personas}, nil
+}
+
+func (r *memoryReferences) AddTicket(ctx context.Context, personaID string, ticketNumero int32) (References, error) {
+	if err := checkID(personaID); err != nil {
+		return References{}, err
+	}
+	personas := r.personas.updateWhere(ctx, func(p *pb.Persona) bool {
+		return p.Id == personaID && !slices.Contains(p.Tickets, ticketNumero)
+	}, func(p *pb.Persona) {
+		p.Tickets = append(p.Tickets, ticketNumero)
+	})
+	return References{Personas: personas}, nil
+}
+
+func (r *memoryReferences) ReleaseProyecto(ctx context.Context, proyecto Ref) (References, error) {
+	personas := r.personas.updateWhere(ctx, inProyecto(proyecto), func(p *pb.Persona) {
+		p.Proyecto = ""
+		p.ProyectoId = ""
+	})
+	return References{Personas: personas}, nil
+}
+
+func (r *memoryReferences) ReplacePersona(ctx context.Context, persona Ref, nuevoNombre string) (References, error) {
+	tickets := r.tickets.updateWhere(ctx, ownedBy(persona), func(t *pb.Ticket) { t.Owner = nuevoNombre })
+	proyectos := r.proyectos.updateWhere(ctx, withColaborador(persona), func(p *pb.Proyecto) {
+		for i := range p.Colaboradores {
+			if colaboradorAt(p, i, persona) {
+				p.Colaboradores[i] = nuevoNombre
+			}
+		}
+	})
+	return References{Tickets: tickets, Proyectos: proyectos}, nil
+}
+
+// memoryTxKey identifica en el ctx la transacción en curso
+type memoryTxKey struct{}
+
+type memorySnapshotter interface {
+	snapshot() func()
+}
+
+// memoryUnitOfWork ejecuta las transacciones de a una: toma gate en modo
+// escritura, de modo que las operaciones de otras solicitudes esperan a que
+// termine y no ven cambios parciales. Si fn falla restaura las tablas.
+type memoryUnitOfWork struct {
+	gate   *sync.RWMutex
+	tables []memorySnapshotter
+}
+
+func (u *memoryUnitOfWork) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(memoryTxKey{}) == u.gate {
+		return fn(ctx)
+	}
+
+	u.gate.Lock()
+	defer u.gate.Unlock()
+
+	restore := make([]func(), len(u.tables))
+	for i, table := range u.tables {
+		restore[i] = table.snapshot()
+	}
+	if err := fn(context.WithValue(ctx, memoryTxKey{}, u.gate)); err != nil {
+		for _, r := range restore {
+			r()
+		}
+		return err
+	}
+	return nil
+}
+
+// RunIfSupported es igual a Run: el store en memoria siempre admite transacciones
+func (u *memoryUnitOfWork) RunIfSupported(ctx context.Context, fn func(ctx context.Context) error) error {
+	return u.Run(ctx, fn)
+}
+
+type memoryIdempotency struct {
+	mu      sync.Mutex
+	records map[string]IdempotencyRecord
+	now     func() time.Time
+}
+
+func (r *memoryIdempotency) Reserve(ctx context.Context, record IdempotencyRecord) (*IdempotencyRecord, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Se aprovecha para descartar las claves vencidas, como haría el índice TTL
+	now := r.now()
+	for key, existing := range r.records {
+		if !existing.ExpiresAt.After(now) {
+			delete(r.records, key)
+		}
+	}
+
+	if existing, ok := r.records[record.Key]; ok {
+		return &existing, false, nil
+	}
+	r.records[record.Key] = record
+	return nil, true, nil
+}
+
+func (r *memoryIdempotency) Complete(ctx context.Context, key, resourceID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if record, ok := r.records[key]; ok {
+		record.ResourceID = resourceID
+		r.records[key] = record
+	}
+	return nil
+}
+
+func (r *memoryIdempotency) Release(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if record, ok := r.records[key]; ok && record.ResourceID == "" {
+		delete(r.records, key)
+	}
+	return nil
+}
@@ -0,0 +1,924 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go-grpc-mongo/model"
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/query"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// CollectionNames - Nombres de las colecciones que usan los repositorios de MongoDB
+type CollectionNames struct {
+	Personas        string
+	Tickets         string
+	Proyectos       string
+	IdempotencyKeys string
+	TicketCounters  string
+	TicketComments  string
+	TicketHistory   string
+}
+
+// NewMongoStore crea los repositorios respaldados por la base de datos de MongoDB
+func NewMongoStore(database *mongo.Database, names CollectionNames) *Store {
+	return &Store{
+		Personas:  &mongoPersonas{collection: database.Collection(names.Personas)},
+		Tickets:   &mongoTickets{collection: database.Collection(names.Tickets)},
+		Proyectos: &mongoProyectos{collection: database.Collection(names.Proyectos)},
+		References: &mongoReferences{
+			personas:  database.Collection(names.Personas),
+			tickets:   database.Collection(names.Tickets),
+			proyectos: database.Collection(names.Proyectos),
+		},
+		Idempotency: &mongoIdempotency{
+			collection: database.Collection(names.IdempotencyKeys),
+			now:        time.Now,
+		},
+		Counters:   &mongoCounters{collection: database.Collection(names.TicketCounters)},
+		Comments:   &mongoTicketComments{collection: database.Collection(names.TicketComments)},
+		History:    &mongoTicketHistory{collection: database.Collection(names.TicketHistory)},
+		UnitOfWork: &mongoUnitOfWork{client: database.Client()},
+	}
+}
+
+// idFilter devuelve el filtro de _id para el ID recibido por gRPC, que puede
+// ser el hexadecimal de un ObjectID o el _id string de un documento cargado a mano
+func idFilter(id string) (bson.M, error) {
+	if err := checkID(id); err != nil {
+		return nil, err
+	}
+	return bson.M{"_id": bson.M{"$in": model.IDValues(id)}}, nil
+}
+
+// eachDocument decodifica cada documento del cursor con el modelo D y lo pasa a fn
+func eachDocument[D any](ctx context.Context, cursor *mongo.Cursor, fn func(D) error) error {
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var doc D
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		if err := fn(doc); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// streamDocuments recorre la colección ordenada por _id, decodifica cada
+// documento con el modelo D y pasa el mensaje a send. Un documento que no se
+// puede decodificar se pasa a failed, o detiene el recorrido si failed es nil.
+func streamDocuments[P any, D interface{ ToProto() P }](ctx context.Context, collection *mongo.Collection,
+	send func(P) error, failed func(DecodeFailure) error) error {
+	cursor, err := collection.Find(ctx, bson.M{}, sortByID())
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var doc D
+		if err := cursor.Decode(&doc); err != nil {
+			if failed == nil {
+				return err
+			}
+			if err := failed(DecodeFailure{ID: model.RawID(cursor.Current).String(), Err: err}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := send(doc.ToProto()); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// findOne decodifica con el modelo D el primer documento que cumple filter
+func findOne[D any](ctx context.Context, collection *mongo.Collection, filter bson.M) (D, error) {
+	var doc D
+	if err := collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return doc, ErrNotFound
+		}
+		return doc, err
+	}
+	return doc, nil
+}
+
+// findByID decodifica con el modelo D el documento con el ID indicado
+func findByID[D any](ctx context.Context, collection *mongo.Collection, id string) (D, error) {
+	filter, err := idFilter(id)
+	if err != nil {
+		var zero D
+		return zero, err
+	}
+	return findOne[D](ctx, collection, filter)
+}
+
+// findPage busca los documentos de la página pedida que cumplen q, en el
+// orden de q. Pide un documento de más para que trimPage sepa si existe una
+// página siguiente.
+func findPage(ctx context.Context, collection *mongo.Collection, q *query.Query, page Page) (*mongo.Cursor, error) {
+	token, err := decodePageToken(page.Token, q)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := q.MongoFilter()
+	if token != nil {
+		filter = bson.M{"$and": bson.A{filter, q.MongoAfter(token.Values, token.LastID)}}
+	}
+	opts := options.Find().SetSort(q.MongoSort())
+	if page.Size > 0 {
+		opts.SetLimit(int64(page.Size) + 1)
+	}
+	return collection.Find(ctx, filter, opts)
+}
+
+// decodePage decodifica con el modelo D la página de cursor, que trae un
+// documento de más si existe una página siguiente. El token se genera con los
+// valores de orden del BSON del último documento (ver encodeRawPageToken).
+func decodePage[P any, D interface{ ToProto() P }](ctx context.Context, cursor *mongo.Cursor, q *query.Query, page Page) ([]P, string, error) {
+	defer cursor.Close(ctx)
+	var resultado []P
+	var last bson.Raw
+	for cursor.Next(ctx) {
+		if page.Size > 0 && len(resultado) == int(page.Size) {
+			token, err := encodeRawPageToken(q, last, model.RawID(last).Value())
+			return resultado, token, err
+		}
+		var doc D
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, "", err
+		}
+		// Current se reutiliza en el siguiente lote
+		last = append(bson.Raw(nil), cursor.Current...)
+		resultado = append(resultado, doc.ToProto())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, "", err
+	}
+	return resultado, "", nil
+}
+
+// sortByID ordena los resultados por _id, el mismo orden que usan los tokens de página
+func sortByID() *options.FindOptions {
+	return options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+}
+
+// writeError traduce las violaciones de índices únicos a ErrAlreadyExists
+func writeError(err error) error {
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w: %v", ErrAlreadyExists, err)
+	}
+	return err
+}
+
+// insertedID devuelve el ID del documento insertado
+func insertedID(result *mongo.InsertOneResult) string {
+	return model.IDOf(result.InsertedID).String()
+}
+
+type mongoPersonas struct {
+	collection *mongo.Collection
+}
+
+// find ejecuta el filtro y decodifica todas las personas encontradas
+func (r *mongoPersonas) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*pb.Persona, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var personas []*pb.Persona
+	err = eachDocument(ctx, cursor, func(doc model.Persona) error {
+		personas = append(personas, doc.ToProto())
+		return nil
+	})
+	return personas, err
+}
+
+func (r *mongoPersonas) List(ctx context.Context, q *query.Query, page Page) ([]*pb.Persona, string, error) {
+	cursor, err := findPage(ctx, r.collection, q, page)
+	if err != nil {
+		return nil, "", err
+	}
+	return decodePage[*pb.Persona, model.Persona](ctx, cursor, q, page)
+}
+
+func (r *mongoPersonas) Stream(ctx context.Context, send func(*pb.Persona) error, failed func(DecodeFailure) error) error {
+	return streamDocuments[*pb.Persona, model.Persona](ctx, r.collection, send, failed)
+}
+
+func (r *mongoPersonas) ListByAgeRange(ctx context.Context, edadMinima, edadMaxima int32) ([]*pb.Persona, error) {
+	filter := bson.M{
+		"edad": bson.M{
+			"$gte": edadMinima,
+			"$lte": edadMaxima,
+		},
+	}
+	return r.find(ctx, filter)
+}
+
+func (r *mongoPersonas) ListByTicket(ctx context.Context, ticketNumero int32) ([]*pb.Persona, error) {
+	return r.find(ctx, bson.M{"tickets": ticketNumero})
+}
+
+func (r *mongoPersonas) Get(ctx context.Context, id string) (*pb.Persona, error) {
+	persona, err := findByID[model.Persona](ctx, r.collection, id)
+	if err != nil {
+		return nil, err
+	}
+	return persona.ToProto(), nil
+}
+
+func (r *mongoPersonas) ListByIDs(ctx context.Context, ids []string) ([]*pb.Persona, error) {
+	return r.find(ctx, bson.M{"_id": bson.M{"$in": model.IDValues(ids...)}})
+}
+
+func (r *mongoPersonas) GetByNombre(ctx context.Context, nombre string) (*pb.Persona, error) {
+	persona, err := findOne[model.Persona](ctx, r.collection, bson.M{"nombre": nombre})
+	if err != nil {
+		return nil, err
+	}
+	return persona.ToProto(), nil
+}
+
+func (r *mongoPersonas) Create(ctx context.Context, persona *pb.Persona) (string, error) {
+	doc := model.PersonaFromProto(persona)
+	doc.Version = InitialVersion
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		return "", writeError(err)
+	}
+	return insertedID(result), nil
+}
+
+func (r *mongoPersonas) Update(ctx context.Context, persona *pb.Persona, fields []string) error {
+	return updateByID(ctx, r.collection, persona.Id, persona.Version, pickFields(bson.M{
+		"nombre":      persona.Nombre,
+		"edad":        persona.Edad,
+		"tickets":     persona.Tickets,
+		"proyecto":    persona.Proyecto,
+		"proyecto_id": persona.ProyectoId,
+	}, fields))
+}
+
+func (r *mongoPersonas) Delete(ctx context.Context, id string, version int64) error {
+	return deleteByID(ctx, r.collection, id, version)
+}
+
+type mongoTickets struct {
+	collection *mongo.Collection
+}
+
+func (r *mongoTickets) List(ctx context.Context, q *query.Query, page Page) ([]*pb.Ticket, string, error) {
+	cursor, err := findPage(ctx, r.collection, q, page)
+	if err != nil {
+		return nil, "", err
+	}
+	return decodePage[*pb.Ticket, model.Ticket](ctx, cursor, q, page)
+}
+
+func (r *mongoTickets) Stream(ctx context.Context, send func(*pb.Ticket) error, failed func(DecodeFailure) error) error {
+	return streamDocuments[*pb.Ticket, model.Ticket](ctx, r.collection, send, failed)
+}
+
+func (r *mongoTickets) findOne(ctx context.Context, filter bson.M) (*pb.Ticket, error) {
+	ticket, err := findOne[model.Ticket](ctx, r.collection, filter)
+	if err != nil {
+		return nil, err
+	}
+	return ticket.ToProto(), nil
+}
+
+func (r *mongoTickets) Get(ctx context.Context, id string) (*pb.Ticket, error) {
+	ticket, err := findByID[model.Ticket](ctx, r.collection, id)
+	if err != nil {
+		return nil, err
+	}
+	return ticket.ToProto(), nil
+}
+
+func (r *mongoTickets) GetByNumero(ctx context.Context, ticketNumero int32) (*pb.Ticket, error) {
+	return r.findOne(ctx, bson.M{"ticket_numero": ticketNumero})
+}
+
+func (r *mongoTickets) GetByOwner(ctx context.Context, owner string) (*pb.Ticket, error) {
+	return r.findOne(ctx, bson.M{"owner": owner})
+}
+
+func (r *mongoTickets) GetByOwnerID(ctx context.Context, ownerID string) (*pb.Ticket, error) {
+	return r.findOne(ctx, bson.M{"owner_id": ownerID})
+}
+
+func (r *mongoTickets) ListByOwner(ctx context.Context, owner Ref) ([]*pb.Ticket, error) {
+	cursor, err := r.collection.Find(ctx, ownerFilter(owner), sortByID())
+	if err != nil {
+		return nil, err
+	}
+	var resultado []*pb.Ticket
+	err = eachDocument(ctx, cursor, func(doc model.Ticket) error {
+		resultado = append(resultado, doc.ToProto())
+		return nil
+	})
+	return resultado, err
+}
+
+func (r *mongoTickets) Create(ctx context.Context, ticket *pb.Ticket) (string, error) {
+	doc := model.TicketFromProto(ticket)
+	doc.Version = InitialVersion
+	doc.CreatedAt = now()
+	doc.UpdatedAt = doc.CreatedAt
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		return "", writeError(err)
+	}
+	return insertedID(result), nil
+}
+
+func (r *mongoTickets) Update(ctx context.Context, ticket *pb.Ticket, fields []string) error {
+	set := pickFields(bson.M{
+		"ticket_numero": ticket.TicketNumero,
+		"owner":         ticket.Owner,
+		"owner_id":      ticket.OwnerId,
+		"huerfano":      ticket.Huerfano,
+		"status":        ticket.Status,
+		"priority":      ticket.Priority,
+		"title":         ticket.Title,
+		"description":   ticket.Description,
+	}, fields)
+	set["updated_at"] = now()
+	return updateByID(ctx, r.collection, ticket.Id, ticket.Version, set)
+}
+
+func (r *mongoTickets) Delete(ctx context.Context, id string, version int64) error {
+	return deleteByID(ctx, r.collection, id, version)
+}
+
+type mongoProyectos struct {
+	collection *mongo.Collection
+}
+
+// List decodifica cada proyecto por separado: uno con un campo de otro tipo
+// (por ejemplo, cargado a mano) se informa en failures y no impide devolver
+// el resto de la página
+func (r *mongoProyectos) List(ctx context.Context, q *query.Query, page Page) ([]*pb.Proyecto, string, []DecodeFailure, error) {
+	cursor, err := findPage(ctx, r.collection, q, page)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	defer cursor.Close(ctx)
+	return decodeProyectoPage(ctx, cursor, q, page)
+}
+
+// decodeProyectoPage decodifica la página de proyectos de cursor, que trae un
+// documento de más si existe una página siguiente
+func decodeProyectoPage(ctx context.Context, cursor *mongo.Cursor, q *query.Query, page Page) ([]*pb.Proyecto, string, []DecodeFailure, error) {
+	var resultado []*pb.Proyecto
+	var failures []DecodeFailure
+	var last bson.Raw
+	for n := 0; cursor.Next(ctx); n++ {
+		if page.Size > 0 && n == int(page.Size) {
+			// Hay más documentos: el token apunta al siguiente del último de
+			// la página, aunque no se haya podido decodificar
+			token, err := encodeRawPageToken(q, last, model.RawID(last).Value())
+			return resultado, token, failures, err
+		}
+
+		// Current se reutiliza en el siguiente lote
+		last = append(bson.Raw(nil), cursor.Current...)
+		var doc model.Proyecto
+		if err := bson.Unmarshal(last, &doc); err != nil {
+			failures = append(failures, DecodeFailure{ID: model.RawID(last).String(), Err: err})
+			continue
+		}
+		resultado = append(resultado, doc.ToProto())
+	}
+	return resultado, "", failures, cursor.Err()
+}
+
+func (r *mongoProyectos) Stream(ctx context.Context, send func(*pb.Proyecto) error, failed func(DecodeFailure) error) error {
+	return streamDocuments[*pb.Proyecto, model.Proyecto](ctx, r.collection, send, failed)
+}
+
+func (r *mongoProyectos) findOne(ctx context.Context, filter bson.M) (*pb.Proyecto, error) {
+	proyecto, err := findOne[model.Proyecto](ctx, r.collection, filter)
+	if err != nil {
+		return nil, err
+	}
+	return proyecto.ToProto(), nil
+}
+
+func (r *mongoProyectos) Get(ctx context.Context, id string) (*pb.Proyecto, error) {
+	proyecto, err := findByID[model.Proyecto](ctx, r.collection, id)
+	if err != nil {
+		return nil, err
+	}
+	return proyecto.ToProto(), nil
+}
+
+func (r *mongoProyectos) ListByIDs(ctx context.Context, ids []string) ([]*pb.Proyecto, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": model.IDValues(ids...)}})
+	if err != nil {
+		return nil, err
+	}
+	var proyectos []*pb.Proyecto
+	err = eachDocument(ctx, cursor, func(doc model.Proyecto) error {
+		proyectos = append(proyectos, doc.ToProto())
+		return nil
+	})
+	return proyectos, err
+}
+
+func (r *mongoProyectos) GetByNombre(ctx context.Context, nombre string) (*pb.Proyecto, error) {
+	return r.findOne(ctx, bson.M{"nombre": nombre})
+}
+
+func (r *mongoProyectos) GetByColaborador(ctx context.Context, colaborador string) (*pb.Proyecto, error) {
+	// Busca proyectos que contengan al colaborador en la lista
+	return r.findOne(ctx, bson.M{"colaboradores": colaborador})
+}
+
+func (r *mongoProyectos) GetByColaboradorID(ctx context.Context, colaboradorID string) (*pb.Proyecto, error) {
+	return r.findOne(ctx, bson.M{"colaborador_ids": colaboradorID})
+}
+
+func (r *mongoProyectos) Create(ctx context.Context, proyecto *pb.Proyecto) (string, error) {
+	doc := model.ProyectoFromProto(proyecto)
+	doc.Version = InitialVersion
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		return "", writeError(err)
+	}
+	return insertedID(result), nil
+}
+
+func (r *mongoProyectos) Update(ctx context.Context, proyecto *pb.Proyecto, fields []string) error {
+	return updateByID(ctx, r.collection, proyecto.Id, proyecto.Version, pickFields(bson.M{
+		"nombre":           proyecto.Nombre,
+		"colaboradores":    proyecto.Colaboradores,
+		"colaborador_ids":  proyecto.ColaboradorIds,
+		"nivel_dificultad": proyecto.NivelDificultad,
+		"dificultad":       proyecto.Dificultad,
+	}, fields))
+}
+
+func (r *mongoProyectos) Delete(ctx context.Context, id string, version int64) error {
+	return deleteByID(ctx, r.collection, id, version)
+}
+
+// pickFields se queda solo con los campos de doc que se van a escribir
+func pickFields(doc bson.M, fields []string) bson.M {
+	set := bson.M{}
+	for _, field := range fields {
+		if value, ok := doc[field]; ok {
+			set[field] = value
+		}
+	}
+	return set
+}
+
+// versionFilter selecciona la versión esperada. Los documentos sin el campo
+// version se consideran versión 0.
+func versionFilter(version int64) interface{} {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// versionConflict explica por qué una operación condicionada a la versión no
+// modificó nada: el documento no existe o tiene otra versión
+func versionConflict(ctx context.Context, collection *mongo.Collection, filter bson.M) error {
+	var current struct {
+		Version int64 `bson:"version"`
+	}
+	opts := options.FindOne().SetProjection(bson.M{"version": 1})
+	if err := collection.FindOne(ctx, filter, opts).Decode(&current); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return ErrNotFound
+		}
+		return err
+	}
+	return &VersionMismatchError{Current: current.Version}
+}
+
+// withVersion agrega al filtro de _id la versión esperada
+func withVersion(filter bson.M, version int64) bson.M {
+	return bson.M{"_id": filter["_id"], "version": versionFilter(version)}
+}
+
+// updateByID aplica $set sobre el documento con el ID indicado si su versión
+// es la esperada, y aumenta la versión en la misma operación
+func updateByID(ctx context.Context, collection *mongo.Collection, id string, version int64, set bson.M) error {
+	filter, err := idFilter(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+	result, err := collection.UpdateOne(ctx, withVersion(filter, version), update)
+	if err != nil {
+		return writeError(err)
+	}
+	if result.MatchedCount == 0 {
+		return versionConflict(ctx, collection, filter)
+	}
+	return nil
+}
+
+// deleteByID elimina el documento con el ID indicado de la colección si su
+// versión es la esperada
+func deleteByID(ctx context.Context, collection *mongo.Collection, id string, version int64) error {
+	filter, err := idFilter(id)
+	if err != nil {
+		return err
+	}
+
+	result, err := collection.DeleteOne(ctx, withVersion(filter, version))
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return versionConflict(ctx, collection, filter)
+	}
+	return nil
+}
+
+// counterDocument - Estructura de un contador en MongoDB; el nombre es el _id
+type counterDocument struct {
+	Name  string `bson:"_id"`
+	Value int32  `bson:"value"`
+}
+
+type mongoCounters struct {
+	collection *mongo.Collection
+}
+
+func (r *mongoCounters) Next(ctx context.Context, name string, first int32) (int32, error) {
+	// $max ignora el campo si todavía no existe
+	pipeline := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"value": bson.M{"$add": bson.A{bson.M{"$max": bson.A{"$value", first - 1}}, 1}},
+	}}}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var doc counterDocument
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": name}, pipeline, opts).Decode(&doc)
+	if mongo.IsDuplicateKeyError(err) {
+		// Otra solicitud creó el contador al mismo tiempo; ahora existe
+		err = r.collection.FindOneAndUpdate(ctx, bson.M{"_id": name}, pipeline, opts).Decode(&doc)
+	}
+	return doc.Value, err
+}
+
+type mongoTicketComments struct {
+	collection *mongo.Collection
+}
+
+func (r *mongoTicketComments) Add(ctx context.Context, comment *pb.TicketComment) (*pb.TicketComment, error) {
+	doc := model.TicketCommentFromProto(comment)
+	doc.CreatedAt = now()
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	doc.ID = model.IDOf(result.InsertedID)
+	return doc.ToProto(), nil
+}
+
+func (r *mongoTicketComments) List(ctx context.Context, ticketID string, page Page) ([]*pb.TicketComment, string, error) {
+	q, err := byTicket(ticketID)
+	if err != nil {
+		return nil, "", err
+	}
+	cursor, err := findPage(ctx, r.collection, q, page)
+	if err != nil {
+		return nil, "", err
+	}
+	return decodePage[*pb.TicketComment, model.TicketComment](ctx, cursor, q, page)
+}
+
+type mongoTicketHistory struct {
+	collection *mongo.Collection
+}
+
+func (r *mongoTicketHistory) Append(ctx context.Context, event *pb.TicketEvent) error {
+	doc := model.TicketEventFromProto(event)
+	doc.CreatedAt = now()
+	_, err := r.collection.InsertOne(ctx, doc)
+	return err
+}
+
+func (r *mongoTicketHistory) List(ctx context.Context, ticketID string, page Page) ([]*pb.TicketEvent, string, error) {
+	q, err := byTicket(ticketID)
+	if err != nil {
+		return nil, "", err
+	}
+	cursor, err := findPage(ctx, r.collection, q, page)
+	if err != nil {
+		return nil, "", err
+	}
+	return decodePage[*pb.TicketEvent, model.TicketEvent](ctx, cursor, q, page)
+}
+
+// idempotencyDocument - Estructura de una clave de idempotencia en MongoDB. La
+// clave es el _id, lo que garantiza que dos solicitudes simultáneas no puedan
+// reservarla a la vez.
+type idempotencyDocument struct {
+	Key         string    `bson:"_id"`
+	RequestHash string    `bson:"request_hash"`
+	ResourceID  string    `bson:"resource_id"`
+	ExpiresAt   time.Time `bson:"expires_at"`
+}
+
+type mongoIdempotency struct {
+	collection *mongo.Collection
+	now        func() time.Time
+}
+
+// Reserve reemplaza la clave solo si ya expiró. Si existe y está vigente el
+// upsert choca con el _id existente y se devuelve el registro guardado.
+func (r *mongoIdempotency) Reserve(ctx context.Context, record IdempotencyRecord) (*IdempotencyRecord, bool, error) {
+	doc := idempotencyDocument{
+		Key:         record.Key,
+		RequestHash: record.RequestHash,
+		ResourceID:  record.ResourceID,
+		ExpiresAt:   record.ExpiresAt,
+	}
+	filter := bson.M{"_id": record.Key, "expires_at": bson.M{"$lte": r.now()}}
+	_, err := r.collection.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true))
+	if err == nil {
+		return nil, true, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, false, err
+	}
+
+	var existing idempotencyDocument
+	if err := r.collection.FindOne(ctx, bson.M{"_id": record.Key}).Decode(&existing); err != nil {
+		return nil, false, err
+	}
+	return &IdempotencyRecord{
+		Key:         existing.Key,
+		RequestHash: existing.RequestHash,
+		ResourceID:  existing.ResourceID,
+		ExpiresAt:   existing.ExpiresAt,
+	}, false, nil
+}
+
+func (r *mongoIdempotency) Complete(ctx context.Context, key, resourceID string) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": key}, bson.M{"$set": bson.M{"resource_id": resourceID}})
+	return err
+}
+
+func (r *mongoIdempotency) Release(ctx context.Context, key string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": key, "resource_id": ""})
+	return err
+}
+
+type mongoReferences struct {
+	personas  *mongo.Collection
+	tickets   *mongo.Collection
+	proyectos *mongo.Collection
+}
+
+// refFilter selecciona los documentos que referencian a ref en idField o, si
+// no tienen idField (anteriores a las referencias por ID), en nameField
+func refFilter(idField, nameField string, ref Ref, legacy interface{}) bson.M {
+	or := bson.A{}
+	if ref.ID != "" {
+		or = append(or, bson.M{idField: ref.ID})
+	}
+	if ref.Nombre != "" {
+		or = append(or, bson.M{nameField: ref.Nombre, idField: bson.M{"$in": legacy}})
+	}
+	if len(or) == 0 {
+		return bson.M{"_id": bson.M{"$exists": false}}
+	}
+	return bson.M{"$or": or}
+}
+
+// Valores de un campo de ID en los documentos anteriores a las referencias por ID
+var (
+	noID  = bson.A{nil, ""}
+	noIDs = bson.A{nil, bson.A{}}
+)
+
+func ownerFilter(persona Ref) bson.M {
+	return refFilter("owner_id", "owner", persona, noID)
+}
+
+func colaboradorFilter(persona Ref) bson.M {
+	return refFilter("colaborador_ids", "colaboradores", persona, noIDs)
+}
+
+func proyectoFilter(proyecto Ref) bson.M {
+	return refFilter("proyecto_id", "proyecto", proyecto, noID)
+}
+
+func (r *mongoReferences) PersonaReferences(ctx context.Context, persona Ref) (References, error) {
+	tickets, err := r.tickets.CountDocuments(ctx, ownerFilter(persona))
+	if err != nil {
+		return References{}, err
+	}
+	proyectos, err := r.proyectos.CountDocuments(ctx, colaboradorFilter(persona))
+	if err != nil {
+		return References{}, err
+	}
+	return References{Tickets: tickets, Proyectos: proyectos}, nil
+}
+
+func (r *mongoReferences) TicketReferences(ctx context.Context, ticketNumero int32) (References, error) {
+	personas, err := r.personas.CountDocuments(ctx, bson.M{"tickets": ticketNumero})
+	return References{Personas: personas}, err
+}
+
+func (r *mongoReferences) ProyectoReferences(ctx context.Context, proyecto Ref) (References, error) {
+	personas, err := r.personas.CountDocuments(ctx, proyectoFilter(proyecto))
+	return References{Personas: personas}, err
+}
+
+// updateReferences aplica update a los documentos que cumplen filter y
+// aumenta su versión; devuelve cuántos se modificaron
+func updateReferences(ctx context.Context, collection *mongo.Collection, filter, update bson.M, opts ...*options.UpdateOptions) (int64, error) {
+	update["$inc"] = bson.M{"version": 1}
+	result, err := collection.UpdateMany(ctx, filter, update, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// updateColaboradores aplica a los proyectos que tienen a persona en colaborador_ids
+// una actualización que recorre ambas listas por posición, para que sigan en
+// el mismo orden. at es la expresión del nuevo valor de la posición $$i de
+// colaboradores, o nil si la posición se quita.
+func updateColaboradores(ctx context.Context, collection *mongo.Collection, persona Ref, at interface{}) (int64, error) {
+	if persona.ID == "" {
+		return 0, nil
+	}
+	positions := bson.M{"$range": bson.A{0, bson.M{"$size": "$colaborador_ids"}}}
+	isPersona := bson.M{"$eq": bson.A{bson.M{"$arrayElemAt": bson.A{"$colaborador_ids", "$$i"}}, persona.ID}}
+	elem := func(field string) bson.M {
+		return bson.M{"$arrayElemAt": bson.A{field, "$$i"}}
+	}
+
+	var set bson.M
+	if at == nil {
+		keep := bson.M{"$filter": bson.M{"input": positions, "as": "i", "cond": bson.M{"$not": bson.A{isPersona}}}}
+		set = bson.M{
+			"colaboradores":   bson.M{"$map": bson.M{"input": keep, "as": "i", "in": elem("$colaboradores")}},
+			"colaborador_ids": bson.M{"$map": bson.M{"input": keep, "as": "i", "in": elem("$colaborador_ids")}},
+		}
+	} else {
+		set = bson.M{
+			"colaboradores": bson.M{"$map": bson.M{"input": positions, "as": "i", "in": bson.M{
+				"$cond": bson.A{isPersona, at, elem("$colaboradores")},
+			}}},
+		}
+	}
+	set["version"] = bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$version", 0}}, 1}}
+
+	result, err := collection.UpdateMany(ctx, bson.M{"colaborador_ids": persona.ID}, mongo.Pipeline{{{Key: "$set", Value: set}}})
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// legacyColaborador selecciona los proyectos sin colaborador_ids que tienen a
+// persona entre los colaboradores
+func legacyColaborador(persona Ref) bson.M {
+	return bson.M{"colaboradores": persona.Nombre, "colaborador_ids": bson.M{"$in": noIDs}}
+}
+
+func (r *mongoReferences) ReleasePersona(ctx context.Context, persona, reassignTo Ref) (References, error) {
+	tickets, err := updateReferences(ctx, r.tickets, ownerFilter(persona), bson.M{"$set": bson.M{
+		"owner":    reassignTo.Nombre,
+		"owner_id": reassignTo.ID,
+		"huerfano": reassignTo == Ref{},
+	}})
+	if err != nil {
+		return References{}, err
+	}
+	proyectos, err := updateColaboradores(ctx, r.proyectos, persona, nil)
+	if err != nil {
+		return References{Tickets: tickets}, err
+	}
+	if persona.Nombre != "" {
+		legacy, err := updateReferences(ctx, r.proyectos, legacyColaborador(persona),
+			bson.M{"$pull": bson.M{"colaboradores": persona.Nombre}})
+		if err != nil {
+			return References{Tickets: tickets, Proyectos: proyectos}, err
+		}
+		proyectos += legacy
+	}
+	return References{Tickets: tickets, Proyectos: proyectos}, nil
+}
+
+func (r *mongoReferences) ReleaseTicket(ctx context.Context, ticketNumero int32) (References, error) {
+	personas, err := updateReferences(ctx, r.personas, bson.M{"tickets": ticketNumero},
+		bson.M{"$pull": bson.M{"tickets": ticketNumero}})
+	return References{Personas: personas}, err
+}
+
+func (r *mongoReferences) AddTicket(ctx context.Context, personaID string, ticketNumero int32) (References, error) {
+	filter, err := idFilter(personaID)
+	if err != nil {
+		return References{}, err
+	}
+	filter["tickets"] = bson.M{"$ne": ticketNumero}
+	personas, err := updateReferences(ctx, r.personas, filter, bson.M{"$push": bson.M{"tickets": ticketNumero}})
+	return References{Personas: personas}, err
+}
+
+func (r *mongoReferences) ReleaseProyecto(ctx context.Context, proyecto Ref) (References, error) {
+	personas, err := updateReferences(ctx, r.personas, proyectoFilter(proyecto),
+		bson.M{"$set": bson.M{"proyecto": "", "proyecto_id": ""}})
+	return References{Personas: personas}, err
+}
+
+func (r *mongoReferences) ReplacePersona(ctx context.Context, persona Ref, nuevoNombre string) (References, error) {
+	tickets, err := updateReferences(ctx, r.tickets, ownerFilter(persona),
+		bson.M{"$set": bson.M{"owner": nuevoNombre}})
+	if err != nil {
+		return References{}, err
+	}
+	proyectos, err := updateColaboradores(ctx, r.proyectos, persona, nuevoNombre)
+	if err != nil {
+		return References{Tickets: tickets}, err
+	}
+	if persona.Nombre != "" {
+		// El filtro de arreglo reemplaza todas las apariciones del nombre, no solo la primera
+		legacy, err := updateReferences(ctx, r.proyectos, legacyColaborador(persona),
+			bson.M{"$set": bson.M{"colaboradores.$[c]": nuevoNombre}},
+			options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{bson.M{"c": persona.Nombre}}}))
+		if err != nil {
+			return References{Tickets: tickets, Proyectos: proyectos}, err
+		}
+		proyectos += legacy
+	}
+	return References{Tickets: tickets, Proyectos: proyectos}, nil
+}
+
+// illegalOperation es el código de error de MongoDB para las transacciones en
+// un servidor standalone
+const illegalOperation = 20
+
+type mongoUnitOfWork struct {
+	client *mongo.Client
+	// unsupported se marca la primera vez que el servidor rechaza una
+	// transacción, para no volver a intentarla en RunIfSupported
+	unsupported atomic.Bool
+}
+
+// Run usa una sesión con lectura snapshot y escritura majority. WithTransaction
+// reintenta fn ante errores transitorios (por ejemplo un conflicto de
+// escritura con otra transacción) y el commit ante resultados desconocidos.
+func (u *mongoUnitOfWork) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	if session := mongo.SessionFromContext(ctx); session != nil {
+		return fn(ctx)
+	}
+
+	session, err := u.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(context.WithoutCancel(ctx))
+
+	opts := options.Transaction().
+		SetReadConcern(readconcern.Snapshot()).
+		SetWriteConcern(writeconcern.Majority())
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	}, opts)
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) && serverErr.HasErrorCode(illegalOperation) {
+		return fmt.Errorf("%w: %v", ErrTransactionsUnsupported, err)
+	}
+	return err
+}
+
+// RunIfSupported intenta Run y, si el servidor no admite transacciones, vuelve
+// a llamar a fn sin sesión. El primer intento no aplicó cambios: sin replica
+// set, MongoDB rechaza la primera operación de la transacción.
+func (u *mongoUnitOfWork) RunIfSupported(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !u.unsupported.Load() {
+		err := u.Run(ctx, fn)
+		if !errors.Is(err, ErrTransactionsUnsupported) {
+			return err
+		}
+		u.unsupported.Store(true)
+	}
+	return fn(ctx)
+}
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"go-grpc-mongo/query"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrInvalidPageToken se devuelve cuando el token de página no fue generado
+// por el servidor o corresponde a otro filtro u orden
+var ErrInvalidPageToken = errors.New("store: token de página inválido")
+
+// Page - Parámetros de paginación por cursor sobre _id
+type Page struct {
+	// Size es la cantidad máxima de documentos a devolver; 0 significa sin límite
+	Size int32
+	// Token es el next_page_token de la página anterior; vacío para la primera
+	Token string
+}
+
+// pageToken es el contenido del token opaco: los valores de orden y el _id
+// del último documento devuelto, y la huella de la consulta que lo generó
+type pageToken struct {
+	Fingerprint string        `bson:"fingerprint,omitempty"`
+	Values      []interface{} `bson:"values,omitempty"`
+	LastID      interface{}   `bson:"last_id"`
+}
+
+// encodePageToken genera el token que apunta a los documentos posteriores a last
+func encodePageToken(q *query.Query, last proto.Message, lastID interface{}) (string, error) {
+	return encodeTokenValues(q, q.SortValues(last), lastID)
+}
+
+// encodeRawPageToken genera el token que apunta a los documentos posteriores a
+// last leyendo los valores de orden del BSON. Lo usan los repositorios de
+// MongoDB, porque el mensaje proto no distingue un campo ausente del valor
+// vacío y MongoDB los ordena distinto.
+func encodeRawPageToken(q *query.Query, last bson.Raw, lastID interface{}) (string, error) {
+	return encodeTokenValues(q, rawSortValues(q, last), lastID)
+}
+
+// rawSortValues lee los valores de los campos de orden de un documento BSON.
+// Un campo ausente o null queda en nil, y los enteros quedan como int64.
+func rawSortValues(q *query.Query, doc bson.Raw) []interface{} {
+	order := q.OrderBy()
+	values := make([]interface{}, len(order))
+	for i, f := range order {
+		value, err := doc.LookupErr(f.Field)
+		if err != nil {
+			continue
+		}
+		switch value.Type {
+		case bson.TypeNull, bson.TypeUndefined:
+		case bson.TypeInt32:
+			values[i] = int64(value.Int32())
+		default:
+			// Un valor que no se puede leer queda en nil
+			_ = value.Unmarshal(&values[i])
+		}
+	}
+	return values
+}
+
+func encodeTokenValues(q *query.Query, values []interface{}, lastID interface{}) (string, error) {
+	data, err := bson.Marshal(pageToken{
+		Fingerprint: q.Fingerprint(),
+		Values:      values,
+		LastID:      lastID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodePageToken valida el token contra la consulta actual. Devuelve nil si
+// el token está vacío.
+func decodePageToken(token string, q *query.Query) (*pageToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var decoded pageToken
+	if err := bson.Unmarshal(data, &decoded); err != nil || decoded.LastID == nil {
+		return nil, ErrInvalidPageToken
+	}
+	if decoded.Fingerprint != q.Fingerprint() || q.CheckValues(decoded.Values) != nil {
+		return nil, ErrInvalidPageToken
+	}
+	// Los _id son ObjectID o strings (ver model.ID)
+	switch decoded.LastID.(type) {
+	case primitive.ObjectID, string:
+	default:
+		return nil, ErrInvalidPageToken
+	}
+	return &decoded, nil
+}
+
+// trimPage recorta el documento extra que se pide para saber si hay otra
+// página y genera el token que apunta a la página siguiente
+func trimPage[T proto.Message](items []T, ids []interface{}, q *query.Query, page Page) ([]T, string, error) {
+	if page.Size <= 0 || len(items) <= int(page.Size) {
+		return items, "", nil
+	}
+	token, err := encodePageToken(q, items[page.Size-1], ids[page.Size-1])
+	if err != nil {
+		return nil, "", err
+	}
+	return items[:page.Size], token, nil
+}
@@ -0,0 +1,214 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/query"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func mustParse(t *testing.T, filter, orderBy string) *query.Query {
+	t.Helper()
+	q, err := query.Parse(filter, orderBy, PersonaFields)
+	if err != nil {
+		t.Fatalf("Parse(%q, %q): %v", filter, orderBy, err)
+	}
+	return q
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	objectID, err := primitive.ObjectIDFromHex("64b7f0a1c2d3e4f5a6b7c8d9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := mustParse(t, "edad > 20", "nombre, edad desc")
+	tests := []struct {
+		name   string
+		values []interface{}
+		lastID interface{}
+	}{
+		{"ObjectID", []interface{}{"Ana", int64(30)}, objectID},
+		{"_id string", []interface{}{"Ana", int64(30)}, "persona-legacy-1"},
+		{"campos ausentes", []interface{}{nil, nil}, "persona-legacy-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := encodeTokenValues(q, tt.values, tt.lastID)
+			if err != nil {
+				t.Fatalf("encodeTokenValues: %v", err)
+			}
+			decoded, err := decodePageToken(token, q)
+			if err != nil {
+				t.Fatalf("decodePageToken: %v", err)
+			}
+			if !reflect.DeepEqual(decoded.Values, tt.values) || decoded.LastID != tt.lastID {
+				t.Errorf("token = %v %v, se esperaba %v %v", decoded.Values, decoded.LastID, tt.values, tt.lastID)
+			}
+		})
+	}
+
+	if decoded, err := decodePageToken("", q); decoded != nil || err != nil {
+		t.Errorf("decodePageToken(\"\") = %v, %v; se esperaba la primera página", decoded, err)
+	}
+}
+
+func TestDecodePageTokenErrors(t *testing.T) {
+	q := mustParse(t, "", "nombre")
+	raw := func(token pageToken) string {
+		data, err := bson.Marshal(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	other, err := encodeTokenValues(mustParse(t, "", "nombre desc"), []interface{}{"Ana"}, "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"no es base64", "%%%"},
+		{"no es BSON", base64.RawURLEncoding.EncodeToString([]byte("hola"))},
+		{"otra consulta", other},
+		{"sin _id", raw(pageToken{Fingerprint: q.Fingerprint(), Values: []interface{}{"Ana"}})},
+		{"_id numérico", raw(pageToken{Fingerprint: q.Fingerprint(), Values: []interface{}{"Ana"}, LastID: int64(7)})},
+		{"valor de otro tipo", raw(pageToken{Fingerprint: q.Fingerprint(), Values: []interface{}{int64(7)}, LastID: "p1"})},
+		{"faltan valores", raw(pageToken{Fingerprint: q.Fingerprint(), LastID: "p1"})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodePageToken(tt.token, q); !errors.Is(err, ErrInvalidPageToken) {
+				t.Errorf("decodePageToken() = %v, se esperaba ErrInvalidPageToken", err)
+			}
+		})
+	}
+}
+
+// Los repositorios de MongoDB generan el token con los valores del BSON, que
+// distinguen el campo ausente del valor vacío
+func TestRawSortValues(t *testing.T) {
+	q := mustParse(t, "", "nombre, edad desc")
+	tests := []struct {
+		name string
+		doc  bson.D
+		want []interface{}
+	}{
+		{"valores", bson.D{{Key: "nombre", Value: "Ana"}, {Key: "edad", Value: int32(30)}}, []interface{}{"Ana", int64(30)}},
+		{"valores vacíos", bson.D{{Key: "nombre", Value: ""}, {Key: "edad", Value: int32(0)}}, []interface{}{"", int64(0)}},
+		{"campos ausentes", bson.D{{Key: "_id", Value: "p1"}}, []interface{}{nil, nil}},
+		{"null", bson.D{{Key: "nombre", Value: nil}, {Key: "edad", Value: nil}}, []interface{}{nil, nil}},
+		{"número de mongosh", bson.D{{Key: "nombre", Value: "Ana"}, {Key: "edad", Value: 30.5}}, []interface{}{"Ana", 30.5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := bson.Marshal(tt.doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := rawSortValues(q, doc)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("rawSortValues() = %#v, se esperaba %#v", got, tt.want)
+			}
+
+			// El token se puede volver a leer y traducir a la consulta de la página siguiente
+			token, err := encodeRawPageToken(q, doc, "p1")
+			if err != nil {
+				t.Fatalf("encodeRawPageToken: %v", err)
+			}
+			decoded, err := decodePageToken(token, q)
+			if err != nil {
+				t.Fatalf("decodePageToken: %v", err)
+			}
+			if want := q.MongoAfter(tt.want, "p1"); !reflect.DeepEqual(q.MongoAfter(decoded.Values, decoded.LastID), want) {
+				t.Errorf("MongoAfter() con el token = %v, se esperaba %v", q.MongoAfter(decoded.Values, decoded.LastID), want)
+			}
+		})
+	}
+}
+
+// Recorrer todas las páginas devuelve cada persona una vez y en orden,
+// también las que no tienen el campo de orden
+func TestMemoryPagination(t *testing.T) {
+	ctx := context.Background()
+	st := NewMemoryStore()
+	personas := []*pb.Persona{
+		{Nombre: "Eva", Edad: 30},
+		{Nombre: "", Edad: 41},
+		{Nombre: "Ana", Edad: 30},
+		{Nombre: "Luis"},
+		{Nombre: "", Edad: 25},
+		{Nombre: "Ana", Edad: 52},
+	}
+	for _, p := range personas {
+		if _, err := st.Personas.Create(ctx, p); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+
+	type row struct {
+		nombre string
+		edad   int32
+	}
+	tests := []struct {
+		filter, orderBy string
+		want            []row
+	}{
+		{"", "nombre, edad", []row{{"", 25}, {"", 41}, {"Ana", 30}, {"Ana", 52}, {"Eva", 30}, {"Luis", 0}}},
+		{"", "nombre desc, edad desc", []row{{"Luis", 0}, {"Eva", 30}, {"Ana", 52}, {"Ana", 30}, {"", 41}, {"", 25}}},
+		{"", "edad, nombre desc", []row{{"Luis", 0}, {"", 25}, {"Eva", 30}, {"Ana", 30}, {"", 41}, {"Ana", 52}}},
+		{`nombre = ""`, "edad desc", []row{{"", 41}, {"", 25}}},
+		{"edad >= 30", "nombre", []row{{"", 41}, {"Ana", 30}, {"Ana", 52}, {"Eva", 30}}},
+	}
+	for _, tt := range tests {
+		for _, size := range []int32{1, 2, 4, 0} {
+			q := mustParse(t, tt.filter, tt.orderBy)
+			var got []row
+			page := Page{Size: size}
+			for {
+				items, next, err := st.Personas.List(ctx, q, page)
+				if err != nil {
+					t.Fatalf("List(%q, %q, %d): %v", tt.filter, tt.orderBy, size, err)
+				}
+				if size > 0 && len(items) > int(size) {
+					t.Fatalf("List(%q, %q, %d) devolvió %d personas", tt.filter, tt.orderBy, size, len(items))
+				}
+				for _, p := range items {
+					got = append(got, row{p.Nombre, p.Edad})
+				}
+				if next == "" {
+					break
+				}
+				page.Token = next
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List(%q, %q, %d) = %v, se esperaba %v", tt.filter, tt.orderBy, size, got, tt.want)
+			}
+		}
+	}
+
+	// Un token no sirve con otra consulta, y en memoria no hay campos ausentes
+	_, next, err := st.Personas.List(ctx, mustParse(t, "", "nombre"), Page{Size: 2})
+	if err != nil || next == "" {
+		t.Fatalf("List() = %q, %v; se esperaba otra página", next, err)
+	}
+	if _, _, err := st.Personas.List(ctx, mustParse(t, "", "edad"), Page{Size: 2, Token: next}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("List() con el token de otra consulta = %v, se esperaba ErrInvalidPageToken", err)
+	}
+	q := mustParse(t, "", "nombre")
+	nilToken, err := encodeTokenValues(q, []interface{}{nil}, "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := st.Personas.List(ctx, q, Page{Size: 2, Token: nilToken}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("List() con un valor nil = %v, se esperaba ErrInvalidPageToken", err)
+	}
+}
@@ -0,0 +1,315 @@
+// Package store define los repositorios que usan los handlers gRPC para
+// acceder a personas, tickets y proyectos, independientemente del backend.
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "go-grpc-mongo/proto"
+	"go-grpc-mongo/query"
+)
+
+var (
+	// ErrNotFound se devuelve cuando no existe ningún documento que cumpla la consulta
+	ErrNotFound = errors.New("store: documento no encontrado")
+	// ErrInvalidID se devuelve cuando el ID recibido está vacío
+	ErrInvalidID = errors.New("store: ID inválido")
+	// ErrAlreadyExists se devuelve cuando una escritura repite el valor de un
+	// campo único (ticket_numero de los tickets o nombre de los proyectos)
+	ErrAlreadyExists = errors.New("store: ya existe un documento con ese valor único")
+	// ErrTransactionsUnsupported se devuelve cuando el servidor de MongoDB no
+	// admite transacciones, es decir, cuando no es parte de un replica set
+	ErrTransactionsUnsupported = errors.New("store: MongoDB solo admite transacciones en un replica set o un clúster sharded")
+)
+
+// checkID verifica un ID recibido por gRPC. Además del hexadecimal de un
+// ObjectID se acepta cualquier string, que es el _id de los documentos
+// cargados a mano.
+func checkID(id string) error {
+	if id == "" {
+		return ErrInvalidID
+	}
+	return nil
+}
+
+// InitialVersion es la versión con la que se crean los documentos. Los
+// documentos anteriores al control de versiones no tienen el campo y se
+// consideran versión 0.
+const InitialVersion int64 = 1
+
+// VersionMismatchError se devuelve cuando la versión recibida en una
+// actualización o eliminación no es la versión actual del documento
+type VersionMismatchError struct {
+	Current int64
+}
+
+func (e *VersionMismatchError) Error() string {
+	return fmt.Sprintf("store: la versión actual del documento es %d", e.Current)
+}
+
+// Campos que se pueden usar en filter y order_by de cada entidad
+var (
+	PersonaFields = query.Schema{
+		"nombre":      query.String,
+		"edad":        query.Int,
+		"tickets":     query.IntList,
+		"proyecto":    query.String,
+		"proyecto_id": query.String,
+	}
+	TicketFields = query.Schema{
+		"ticket_numero": query.Int,
+		"owner":         query.String,
+		"owner_id":      query.String,
+		"status":        query.Enum(pb.TicketStatus(0).Descriptor()),
+		"priority":      query.Enum(pb.TicketPriority(0).Descriptor()),
+		"title":         query.String,
+	}
+	ProyectoFields = query.Schema{
+		"nombre":           query.String,
+		"colaboradores":    query.StringList,
+		"colaborador_ids":  query.StringList,
+		"nivel_dificultad": query.String,
+		"dificultad":       query.Enum(pb.Dificultad(0).Descriptor()),
+	}
+)
+
+// Campos que se pueden escribir en las actualizaciones, en el orden en que se
+// aplican cuando se reemplaza el documento completo. Cada referencia se guarda
+// por ID y por nombre, y ambos campos se escriben siempre juntos.
+var (
+	PersonaUpdateFields  = []string{"nombre", "edad", "proyecto", "proyecto_id"}
+	TicketUpdateFields   = []string{"ticket_numero", "owner", "owner_id", "title", "description", "priority"}
+	ProyectoUpdateFields = []string{"nombre", "colaboradores", "colaborador_ids", "nivel_dificultad", "dificultad"}
+)
+
+// ReferenceFields relaciona el campo con el nombre de cada referencia con el
+// campo con el ID
+var ReferenceFields = map[string]string{
+	"proyecto":      "proyecto_id",
+	"owner":         "owner_id",
+	"colaboradores": "colaborador_ids",
+}
+
+// Ref - Referencia a una persona o un proyecto. Los documentos guardan el ID y
+// una copia del nombre; los anteriores a las referencias por ID solo tienen el
+// nombre, y se consideran referencias a Ref si el nombre coincide.
+type Ref struct {
+	ID     string
+	Nombre string
+}
+
+// PersonaRepository - Operaciones sobre la colección de personas
+type PersonaRepository interface {
+	// List devuelve una página de las personas que cumplen q, en el orden de q,
+	// y el token de la página siguiente. q puede ser nil.
+	List(ctx context.Context, q *query.Query, page Page) ([]*pb.Persona, string, error)
+	// Stream llama a send con cada persona a medida que se lee, ordenadas por
+	// _id. Las que no se pueden decodificar se pasan a failed y el recorrido
+	// sigue; si failed es nil, la primera detiene el recorrido con el error.
+	Stream(ctx context.Context, send func(*pb.Persona) error, failed func(DecodeFailure) error) error
+	ListByAgeRange(ctx context.Context, edadMinima, edadMaxima int32) ([]*pb.Persona, error)
+	ListByTicket(ctx context.Context, ticketNumero int32) ([]*pb.Persona, error)
+	Get(ctx context.Context, id string) (*pb.Persona, error)
+	// ListByIDs devuelve las personas con los IDs indicados que existen, en
+	// cualquier orden
+	ListByIDs(ctx context.Context, ids []string) ([]*pb.Persona, error)
+	GetByNombre(ctx context.Context, nombre string) (*pb.Persona, error)
+	Create(ctx context.Context, persona *pb.Persona) (string, error)
+	// Update escribe solo los campos de fields, que deben pertenecer a
+	// PersonaUpdateFields o ser tickets; el resto del documento no se
+	// modifica. tickets sigue al dueño de cada ticket y solo lo escribe
+	// ReconcileTicketOwners. Solo se aplica si persona.Version es la versión
+	// actual, que luego aumenta en 1.
+	Update(ctx context.Context, persona *pb.Persona, fields []string) error
+	// Delete elimina la persona solo si version es su versión actual
+	Delete(ctx context.Context, id string, version int64) error
+}
+
+// TicketRepository - Operaciones sobre la colección de tickets
+type TicketRepository interface {
+	List(ctx context.Context, q *query.Query, page Page) ([]*pb.Ticket, string, error)
+	Stream(ctx context.Context, send func(*pb.Ticket) error, failed func(DecodeFailure) error) error
+	Get(ctx context.Context, id string) (*pb.Ticket, error)
+	GetByNumero(ctx context.Context, ticketNumero int32) (*pb.Ticket, error)
+	GetByOwner(ctx context.Context, owner string) (*pb.Ticket, error)
+	GetByOwnerID(ctx context.Context, ownerID string) (*pb.Ticket, error)
+	// ListByOwner devuelve todos los tickets cuyo dueño es owner, ordenados
+	// por _id, con el mismo criterio que ReferenceRepository
+	ListByOwner(ctx context.Context, owner Ref) ([]*pb.Ticket, error)
+	// Create asigna created_at y updated_at
+	Create(ctx context.Context, ticket *pb.Ticket) (string, error)
+	// Update escribe los campos de fields, como PersonaRepository.Update, y
+	// además actualiza updated_at
+	Update(ctx context.Context, ticket *pb.Ticket, fields []string) error
+	Delete(ctx context.Context, id string, version int64) error
+}
+
+// now devuelve la hora actual con la precisión con que MongoDB guarda las
+// fechas, para que los dos backends devuelvan los mismos timestamps
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// DecodeFailure - Documento que no se pudo decodificar y se omitió de un
+// listado o de un recorrido
+type DecodeFailure struct {
+	ID  string
+	Err error
+}
+
+// ProyectoRepository - Operaciones sobre la colección de proyectos
+type ProyectoRepository interface {
+	// List devuelve una página de los proyectos que cumplen q, como
+	// PersonaRepository.List. Los documentos que no se pueden decodificar se
+	// omiten y se devuelven en failures; cuentan para el tamaño de la página.
+	List(ctx context.Context, q *query.Query, page Page) (proyectos []*pb.Proyecto, next string, failures []DecodeFailure, err error)
+	Stream(ctx context.Context, send func(*pb.Proyecto) error, failed func(DecodeFailure) error) error
+	Get(ctx context.Context, id string) (*pb.Proyecto, error)
+	// ListByIDs devuelve los proyectos con los IDs indicados que existen, en
+	// cualquier orden
+	ListByIDs(ctx context.Context, ids []string) ([]*pb.Proyecto, error)
+	GetByNombre(ctx context.Context, nombre string) (*pb.Proyecto, error)
+	GetByColaborador(ctx context.Context, colaborador string) (*pb.Proyecto, error)
+	GetByColaboradorID(ctx context.Context, colaboradorID string) (*pb.Proyecto, error)
+	Create(ctx context.Context, proyecto *pb.Proyecto) (string, error)
+	Update(ctx context.Context, proyecto *pb.Proyecto, fields []string) error
+	Delete(ctx context.Context, id string, version int64) error
+}
+
+// IdempotencyRecord - Resultado guardado de una creación con idempotency_key
+type IdempotencyRecord struct {
+	// Key identifica la operación y la clave enviada por el cliente
+	Key string
+	// RequestHash es la huella del payload de la solicitud original
+	RequestHash string
+	// ResourceID es el ID creado; vacío mientras la creación está en curso
+	ResourceID string
+	// ExpiresAt es el momento a partir del cual la clave se puede reutilizar
+	ExpiresAt time.Time
+}
+
+// IdempotencyRepository - Claves de idempotencia de las operaciones de creación
+type IdempotencyRepository interface {
+	// Reserve guarda record si la clave no existe o ya expiró y devuelve true.
+	// Si la clave está vigente no la modifica y devuelve el registro guardado.
+	Reserve(ctx context.Context, record IdempotencyRecord) (*IdempotencyRecord, bool, error)
+	// Complete asocia el ID creado a una clave reservada
+	Complete(ctx context.Context, key, resourceID string) error
+	// Release borra una clave reservada cuya creación falló, para que se pueda reintentar
+	Release(ctx context.Context, key string) error
+}
+
+// References - Cantidad de documentos de cada colección que referencian a otro
+type References struct {
+	Personas  int64
+	Tickets   int64
+	Proyectos int64
+}
+
+// Any indica si hay alguna referencia
+func (r References) Any() bool {
+	return r.Personas > 0 || r.Tickets > 0 || r.Proyectos > 0
+}
+
+// ReferenceRepository - Referencias entre colecciones: los tickets de una
+// persona (personas.tickets, por número), su proyecto (personas.proyecto_id),
+// el dueño de un ticket (tickets.owner_id) y los colaboradores de un proyecto
+// (proyectos.colaborador_ids). Los documentos modificados aumentan su versión.
+type ReferenceRepository interface {
+	// PersonaReferences cuenta los tickets cuyo dueño es persona y los
+	// proyectos que la tienen como colaboradora
+	PersonaReferences(ctx context.Context, persona Ref) (References, error)
+	// TicketReferences cuenta las personas que tienen el ticket
+	TicketReferences(ctx context.Context, ticketNumero int32) (References, error)
+	// ProyectoReferences cuenta las personas asignadas al proyecto
+	ProyectoReferences(ctx context.Context, proyecto Ref) (References, error)
+
+	// ReleasePersona reasigna a reassignTo los tickets de persona, o si
+	// reassignTo está vacío los deja sin dueño y marcados como huérfanos, y
+	// quita a persona de los colaboradores de los proyectos
+	ReleasePersona(ctx context.Context, persona, reassignTo Ref) (References, error)
+	// ReleaseTicket quita el ticket de las personas que lo tienen
+	ReleaseTicket(ctx context.Context, ticketNumero int32) (References, error)
+	// AddTicket agrega el ticket a los tickets de la persona con el ID
+	// indicado, si todavía no lo tiene
+	AddTicket(ctx context.Context, personaID string, ticketNumero int32) (References, error)
+	// ReleaseProyecto deja sin proyecto a las personas asignadas a proyecto
+	ReleaseProyecto(ctx context.Context, proyecto Ref) (References, error)
+	// ReplacePersona reemplaza la copia del nombre de persona por nuevoNombre
+	// en el owner de los tickets y en los colaboradores de los proyectos
+	ReplacePersona(ctx context.Context, persona Ref, nuevoNombre string) (References, error)
+}
+
+// CounterRepository - Contadores con los que se numeran los tickets
+type CounterRepository interface {
+	// Next aumenta en 1 el contador name y devuelve el valor nuevo. Nunca
+	// devuelve un valor menor que first, aunque el contador no exista o se
+	// haya quedado atrás.
+	Next(ctx context.Context, name string, first int32) (int32, error)
+}
+
+// ticketIDFields es el único campo por el que se listan los comentarios y el
+// historial de un ticket
+var ticketIDFields = query.Schema{"ticket_id": query.String}
+
+// byTicket devuelve la consulta de los comentarios o eventos de un ticket. El
+// ID queda en la huella de la consulta, así que un token de página solo sirve
+// para el mismo ticket.
+func byTicket(ticketID string) (*query.Query, error) {
+	if err := checkID(ticketID); err != nil {
+		return nil, err
+	}
+	return query.Parse("ticket_id = "+query.Quote(ticketID), "", ticketIDFields)
+}
+
+// TicketCommentRepository - Operaciones sobre la colección de comentarios de tickets
+type TicketCommentRepository interface {
+	// Add guarda el comentario y lo devuelve con el ID y created_at asignados
+	Add(ctx context.Context, comment *pb.TicketComment) (*pb.TicketComment, error)
+	// List devuelve una página de los comentarios del ticket en el orden en
+	// que se agregaron, y el token de la página siguiente
+	List(ctx context.Context, ticketID string, page Page) ([]*pb.TicketComment, string, error)
+}
+
+// TicketHistoryRepository - Operaciones sobre el historial de los tickets. Los
+// eventos solo se agregan: no se modifican ni se borran.
+type TicketHistoryRepository interface {
+	// Append guarda el evento con created_at asignado
+	Append(ctx context.Context, event *pb.TicketEvent) error
+	// List devuelve una página de los eventos del ticket en el orden en que
+	// ocurrieron, y el token de la página siguiente
+	List(ctx context.Context, ticketID string, page Page) ([]*pb.TicketEvent, string, error)
+}
+
+// UnitOfWork agrupa operaciones de varios repositorios, incluso de distintas
+// colecciones, para que se confirmen todas o ninguna
+type UnitOfWork interface {
+	// Run llama a fn dentro de una transacción. Los repositorios participan de
+	// la transacción solo si reciben el ctx que se pasa a fn. Si fn devuelve
+	// error se descartan todos sus cambios y Run devuelve ese error. fn puede
+	// ejecutarse más de una vez si la transacción se reintenta por un conflicto
+	// transitorio, por lo que no debe tener otros efectos. Un Run dentro de fn
+	// se une a la transacción en curso.
+	Run(ctx context.Context, fn func(ctx context.Context) error) error
+	// RunIfSupported es como Run, pero si la base de datos no admite
+	// transacciones (MongoDB sin replica set) llama a fn sin transacción y sus
+	// cambios se aplican de a uno. Es para las operaciones que funcionaban
+	// antes de las transacciones y no deben dejar de funcionar sin ellas.
+	RunIfSupported(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Store agrupa los repositorios que recibe el servidor al construirse
+type Store struct {
+	Personas    PersonaRepository
+	Tickets     TicketRepository
+	Proyectos   ProyectoRepository
+	Idempotency IdempotencyRepository
+	References  ReferenceRepository
+	Counters    CounterRepository
+	Comments    TicketCommentRepository
+	History     TicketHistoryRepository
+	UnitOfWork  UnitOfWork
+}
@@ -0,0 +1,126 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// field devuelve el descriptor de name; For ya verificó que exista
+func field(m protoreflect.Message, name string) protoreflect.FieldDescriptor {
+	return m.Descriptor().Fields().ByName(protoreflect.Name(name))
+}
+
+func violation(field, format string, args ...interface{}) *Violation {
+	return &Violation{Field: field, Description: fmt.Sprintf(format, args...)}
+}
+
+// Required exige un string que no esté vacío ni tenga solo espacios
+func Required(name string) Rule {
+	return Rule{fields: []string{name}, check: func(m protoreflect.Message) []*Violation {
+		if strings.TrimSpace(m.Get(field(m, name)).String()) == "" {
+			return []*Violation{violation(name, "es obligatorio")}
+		}
+		return nil
+	}}
+}
+
+// AnyRequired exige que al menos uno de los strings no esté vacío. La
+// violación se informa en el primer campo.
+func AnyRequired(names ...string) Rule {
+	return Rule{fields: names, check: func(m protoreflect.Message) []*Violation {
+		for _, name := range names {
+			if strings.TrimSpace(m.Get(field(m, name)).String()) != "" {
+				return nil
+			}
+		}
+		return []*Violation{violation(names[0], "se debe indicar %s", strings.Join(names, " o "))}
+	}}
+}
+
+// MaxLength limita la cantidad de caracteres de un string
+func MaxLength(name string, max int) Rule {
+	return Rule{fields: []string{name}, check: func(m protoreflect.Message) []*Violation {
+		if utf8.RuneCountInString(m.Get(field(m, name)).String()) > max {
+			return []*Violation{violation(name, "no puede superar los %d caracteres", max)}
+		}
+		return nil
+	}}
+}
+
+// Range exige un entero entre min y max inclusive
+func Range(name string, min, max int64) Rule {
+	return Rule{fields: []string{name}, check: func(m protoreflect.Message) []*Violation {
+		if v := m.Get(field(m, name)).Int(); v < min || v > max {
+			return []*Violation{violation(name, "debe estar entre %d y %d", min, max)}
+		}
+		return nil
+	}}
+}
+
+// Positive exige un entero mayor que 0. En un campo repetido se verifica cada
+// elemento y la violación indica la posición, por ejemplo tickets[2].
+func Positive(name string) Rule {
+	return Rule{fields: []string{name}, check: func(m protoreflect.Message) []*Violation {
+		fd := field(m, name)
+		if !fd.IsList() {
+			if m.Get(fd).Int() <= 0 {
+				return []*Violation{violation(name, "debe ser mayor que 0")}
+			}
+			return nil
+		}
+		var violations []*Violation
+		list := m.Get(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			if list.Get(i).Int() <= 0 {
+				violations = append(violations, violation(fmt.Sprintf("%s[%d]", name, i), "debe ser mayor que 0"))
+			}
+		}
+		return violations
+	}}
+}
+
+// Defined exige que un enum tenga uno de los valores declarados en el proto
+func Defined(name string) Rule {
+	return Rule{fields: []string{name}, check: func(m protoreflect.Message) []*Violation {
+		fd := field(m, name)
+		if fd.Enum().Values().ByNumber(m.Get(fd).Enum()) == nil {
+			return []*Violation{violation(name, "no es un valor de %s", fd.Enum().Name())}
+		}
+		return nil
+	}}
+}
+
+// Specified exige que un enum tenga un valor distinto de 0 (XXX_UNSPECIFIED)
+func Specified(name string) Rule {
+	return Rule{fields: []string{name}, check: func(m protoreflect.Message) []*Violation {
+		if m.Get(field(m, name)).Enum() == 0 {
+			return []*Violation{violation(name, "es obligatorio")}
+		}
+		return nil
+	}}
+}
+
+// Satisfies exige que ok acepte el valor del campo; si no, la violación
+// tiene la descripción indicada
+func Satisfies(name, description string, ok func(protoreflect.Value) bool) Rule {
+	return Rule{fields: []string{name}, check: func(m protoreflect.Message) []*Violation {
+		if !ok(m.Get(field(m, name))) {
+			return []*Violation{violation(name, "%s", description)}
+		}
+		return nil
+	}}
+}
+
+// NotGreater exige que el entero a no sea mayor que b. La violación se
+// informa en a.
+func NotGreater(a, b string) Rule {
+	return Rule{fields: []string{a, b}, check: func(m protoreflect.Message) []*Violation {
+		if m.Get(field(m, a)).Int() > m.Get(field(m, b)).Int() {
+			return []*Violation{violation(a, "no puede ser mayor que %s", b)}
+		}
+		return nil
+	}}
+}
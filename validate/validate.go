@@ -0,0 +1,144 @@
+// Package validate verifica las solicitudes gRPC con reglas declaradas por
+// tipo de mensaje. Un interceptor aplica las reglas antes de llamar al
+// handler y, si alguna falla, responde INVALID_ARGUMENT con un detalle
+// google.rpc.BadRequest que indica el campo y el motivo de cada violación.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation es el detalle de un campo inválido
+type Violation = errdetails.BadRequest_FieldViolation
+
+// Rule verifica uno o más campos de un mensaje
+type Rule struct {
+	fields []string
+	check  func(msg protoreflect.Message) []*Violation
+}
+
+// MessageRules son las reglas de un tipo de mensaje
+type MessageRules struct {
+	name  protoreflect.FullName
+	rules []Rule
+	// maskable son los campos que el cliente puede elegir con update_mask
+	maskable []string
+}
+
+// For declara las reglas de los mensajes del tipo de msg. Entra en pánico si
+// una regla nombra un campo que el mensaje no tiene, para detectar el error
+// al iniciar el servidor.
+func For(msg proto.Message, rules ...Rule) MessageRules {
+	desc := msg.ProtoReflect().Descriptor()
+	for _, rule := range rules {
+		for _, field := range rule.fields {
+			if desc.Fields().ByName(protoreflect.Name(field)) == nil {
+				panic(fmt.Sprintf("validate: %s no tiene el campo %q", desc.FullName(), field))
+			}
+		}
+	}
+	return MessageRules{name: desc.FullName(), rules: rules}
+}
+
+// ForUpdate declara las reglas de una solicitud de actualización con
+// update_mask. Las reglas de los campos de maskable se verifican solo si el
+// campo está en la máscara; las de los demás campos, como id o version, se
+// verifican siempre.
+func ForUpdate(msg proto.Message, maskable []string, rules ...Rule) MessageRules {
+	r := For(msg, rules...)
+	r.maskable = maskable
+	return r
+}
+
+// masked indica si la regla se omite con la máscara mask: todos sus campos se
+// pueden elegir con update_mask y ninguno está en la máscara
+func (r MessageRules) masked(rule Rule, mask []string) bool {
+	if mask == nil {
+		return false
+	}
+	for _, field := range rule.fields {
+		if !slices.Contains(r.maskable, field) || slices.Contains(mask, field) {
+			return false
+		}
+	}
+	return true
+}
+
+// Rules reúne las reglas de todos los mensajes que se validan
+type Rules []MessageRules
+
+// Check aplica a msg las reglas de su tipo. En las reglas declaradas con
+// ForUpdate, si el mensaje tiene update_mask con campos se omiten las de los
+// campos que quedaron fuera de la máscara. Devuelve nil si no hay violaciones
+// o el tipo no tiene reglas.
+func (r Rules) Check(msg proto.Message) []*Violation {
+	m := msg.ProtoReflect()
+	var violations []*Violation
+	for _, rules := range r {
+		if rules.name != m.Descriptor().FullName() {
+			continue
+		}
+		mask := updateMask(m)
+		for _, rule := range rules.rules {
+			if rules.masked(rule, mask) {
+				continue
+			}
+			violations = append(violations, rule.check(m)...)
+		}
+	}
+	return violations
+}
+
+// updateMask devuelve los campos de update_mask, o nil si el mensaje no
+// tiene máscara o reemplaza todos los campos
+func updateMask(m protoreflect.Message) []string {
+	fd := m.Descriptor().Fields().ByName("update_mask")
+	if fd == nil || fd.Message() == nil || !m.Has(fd) {
+		return nil
+	}
+	paths := m.Get(fd).Message().Get(fd.Message().Fields().ByName("paths")).List()
+	if paths.Len() == 0 || (paths.Len() == 1 && paths.Get(0).String() == "*") {
+		return nil
+	}
+	mask := make([]string, paths.Len())
+	for i := range mask {
+		mask[i] = paths.Get(i).String()
+	}
+	return mask
+}
+
+// Error devuelve INVALID_ARGUMENT con las violaciones como detalle BadRequest
+func Error(violations []*Violation) error {
+	message := fmt.Sprintf("%s: %s", violations[0].Field, violations[0].Description)
+	if len(violations) > 1 {
+		message = fmt.Sprintf("%s (y %d campos inválidos más)", message, len(violations)-1)
+	}
+	st := status.New(codes.InvalidArgument, message)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// UnaryServerInterceptor rechaza las solicitudes que no cumplen las reglas
+// antes de llamar al handler
+func UnaryServerInterceptor(rules Rules) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if violations := rules.Check(msg); len(violations) > 0 {
+				return nil, Error(violations)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
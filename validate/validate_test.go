@@ -0,0 +1,201 @@
+package validate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "go-grpc-mongo/proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// rules usa una regla de cada tipo sobre mensajes del servicio
+var rules = Rules{
+	ForUpdate(&pb.UpdatePersonaRequest{}, []string{"nombre", "edad", "tickets", "proyecto_id"},
+		Required("id"),
+		Required("nombre"),
+		MaxLength("nombre", 5),
+		Range("edad", 0, 150),
+		Positive("tickets"),
+		Positive("version"),
+	),
+	For(&pb.CreateTicketRequest{},
+		Positive("ticket_numero"),
+		AnyRequired("owner_id", "owner"),
+		Specified("priority"),
+		Defined("priority"),
+		Satisfies("sequence", "debe tener 3 caracteres", func(v protoreflect.Value) bool {
+			return v.String() == "" || len(v.String()) == 3
+		}),
+	),
+	For(&pb.GetPersonasByAgeRangeRequest{}, NotGreater("edadMinima", "edadMaxima")),
+}
+
+func mask(paths ...string) *fieldmaskpb.FieldMask { return &fieldmaskpb.FieldMask{Paths: paths} }
+
+func TestCheck(t *testing.T) {
+	persona := func(edit func(*pb.UpdatePersonaRequest)) *pb.UpdatePersonaRequest {
+		req := &pb.UpdatePersonaRequest{Id: "p1", Nombre: "Ana", Edad: 30, Tickets: []int32{104}, Version: 1}
+		edit(req)
+		return req
+	}
+	ticket := func(edit func(*pb.CreateTicketRequest)) *pb.CreateTicketRequest {
+		req := &pb.CreateTicketRequest{TicketNumero: 104, OwnerId: "p1", Priority: pb.TicketPriority_TICKET_PRIORITY_HIGH}
+		edit(req)
+		return req
+	}
+	tests := []struct {
+		name string
+		req  interface{ ProtoReflect() protoreflect.Message }
+		want []string // campos con violaciones, en orden
+	}{
+		{"persona válida", persona(func(*pb.UpdatePersonaRequest) {}), nil},
+		{"Required vacío", persona(func(r *pb.UpdatePersonaRequest) { r.Nombre = "" }), []string{"nombre"}},
+		{"Required con espacios", persona(func(r *pb.UpdatePersonaRequest) { r.Id = "  " }), []string{"id"}},
+		{"MaxLength cuenta caracteres", persona(func(r *pb.UpdatePersonaRequest) { r.Nombre = "Íñigo" }), nil},
+		{"MaxLength superado", persona(func(r *pb.UpdatePersonaRequest) { r.Nombre = "Ignacio" }), []string{"nombre"}},
+		{"Range debajo", persona(func(r *pb.UpdatePersonaRequest) { r.Edad = -1 }), []string{"edad"}},
+		{"Range arriba", persona(func(r *pb.UpdatePersonaRequest) { r.Edad = 151 }), []string{"edad"}},
+		{"Positive escalar", persona(func(r *pb.UpdatePersonaRequest) { r.Version = 0 }), []string{"version"}},
+		{"Positive en una lista", persona(func(r *pb.UpdatePersonaRequest) { r.Tickets = []int32{104, 0, 5, -2} }), []string{"tickets[1]", "tickets[3]"}},
+		{"varias violaciones", persona(func(r *pb.UpdatePersonaRequest) { r.Id, r.Edad = "", 200 }), []string{"id", "edad"}},
+
+		// Con update_mask solo se verifican los campos de la máscara y los que no se pueden enmascarar
+		{"fuera de la máscara", persona(func(r *pb.UpdatePersonaRequest) { r.Nombre, r.UpdateMask = "", mask("edad") }), nil},
+		{"dentro de la máscara", persona(func(r *pb.UpdatePersonaRequest) { r.Nombre, r.UpdateMask = "", mask("nombre") }), []string{"nombre"}},
+		{"campo no enmascarable", persona(func(r *pb.UpdatePersonaRequest) { r.Version, r.UpdateMask = 0, mask("edad") }), []string{"version"}},
+		{"máscara con *", persona(func(r *pb.UpdatePersonaRequest) { r.Nombre, r.UpdateMask = "", mask("*") }), []string{"nombre"}},
+		{"máscara vacía", persona(func(r *pb.UpdatePersonaRequest) { r.Nombre, r.UpdateMask = "", mask() }), []string{"nombre"}},
+
+		{"ticket válido", ticket(func(*pb.CreateTicketRequest) {}), nil},
+		{"AnyRequired con el segundo campo", ticket(func(r *pb.CreateTicketRequest) { r.OwnerId, r.Owner = "", "Ana" }), nil},
+		{"AnyRequired sin ninguno", ticket(func(r *pb.CreateTicketRequest) { r.OwnerId = "" }), []string{"owner_id"}},
+		{"Specified", ticket(func(r *pb.CreateTicketRequest) { r.Priority = pb.TicketPriority_TICKET_PRIORITY_UNSPECIFIED }), []string{"priority"}},
+		{"Defined", ticket(func(r *pb.CreateTicketRequest) { r.Priority = 42 }), []string{"priority"}},
+		{"Satisfies", ticket(func(r *pb.CreateTicketRequest) { r.Sequence = "ab" }), []string{"sequence"}},
+
+		{"NotGreater iguales", &pb.GetPersonasByAgeRangeRequest{EdadMinima: 30, EdadMaxima: 30}, nil},
+		{"NotGreater mayor", &pb.GetPersonasByAgeRangeRequest{EdadMinima: 40, EdadMaxima: 30}, []string{"edadMinima"}},
+		{"mensaje sin reglas", &pb.GetTicketPorNumeroRequest{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, v := range rules.Check(tt.req) {
+				got = append(got, v.Field)
+				if v.Description == "" {
+					t.Errorf("la violación de %s no tiene descripción", v.Field)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check() campos = %v, se esperaba %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForUnknownField(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("For() aceptó una regla sobre un campo que no existe")
+		}
+	}()
+	For(&pb.CreateTicketRequest{}, Required("apellido"))
+}
+
+// badRequest verifica que err sea INVALID_ARGUMENT y devuelve los campos de su
+// detalle BadRequest
+func badRequest(t *testing.T, err error) []string {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("código = %v (%v), se esperaba InvalidArgument", st.Code(), err)
+	}
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			var fields []string
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+			return fields
+		}
+	}
+	t.Fatalf("%v no tiene un detalle BadRequest", err)
+	return nil
+}
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		name       string
+		violations []*Violation
+		message    string
+	}{
+		{"una violación", []*Violation{{Field: "nombre", Description: "es obligatorio"}}, "nombre: es obligatorio"},
+		{"varias violaciones", []*Violation{
+			{Field: "nombre", Description: "es obligatorio"},
+			{Field: "edad", Description: "debe estar entre 0 y 150"},
+			{Field: "tickets[0]", Description: "debe ser mayor que 0"},
+		}, "nombre: es obligatorio (y 2 campos inválidos más)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Error(tt.violations)
+			if got := status.Convert(err).Message(); got != tt.message {
+				t.Errorf("mensaje = %q, se esperaba %q", got, tt.message)
+			}
+			got := badRequest(t, err)
+			if len(got) != len(tt.violations) {
+				t.Fatalf("BadRequest campos = %v, se esperaban %d", got, len(tt.violations))
+			}
+			for i, v := range tt.violations {
+				if got[i] != v.Field {
+					t.Errorf("BadRequest campo %d = %q, se esperaba %q", i, got[i], v.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor(rules)
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.TicketsService/CreateTicket"}
+	tests := []struct {
+		name    string
+		req     interface{}
+		handled bool
+		fields  []string // campos del detalle BadRequest si se rechaza
+	}{
+		{"válida", &pb.CreateTicketRequest{TicketNumero: 104, OwnerId: "p1", Priority: pb.TicketPriority_TICKET_PRIORITY_LOW}, true, nil},
+		{"inválida", &pb.CreateTicketRequest{TicketNumero: -1, Priority: 42}, false, []string{"ticket_numero", "owner_id", "priority"}},
+		{"mensaje sin reglas", &pb.GetTicketPorNumeroRequest{}, true, nil},
+		{"no es proto", "hola", true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handled = true
+				return "ok", nil
+			}
+			resp, err := interceptor(context.Background(), tt.req, info, handler)
+			if handled != tt.handled {
+				t.Fatalf("se llamó al handler = %v, se esperaba %v", handled, tt.handled)
+			}
+			if tt.handled {
+				if err != nil || resp != "ok" {
+					t.Errorf("interceptor() = %v, %v; se esperaba la respuesta del handler", resp, err)
+				}
+				return
+			}
+			if got := badRequest(t, err); !reflect.DeepEqual(got, tt.fields) {
+				t.Errorf("BadRequest campos = %v, se esperaba %v", got, tt.fields)
+			}
+		})
+	}
+}